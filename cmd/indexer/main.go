@@ -2,26 +2,31 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"flag"
 	"os"
 	"os/signal"
-	"strings"
+	"strconv"
 	"syscall"
 	"time"
 
 	"github.com/BurntSushi/toml"
 	"go.uber.org/zap"
 
+	"github.com/the-laziest/namadexer-go/internal/checksums"
 	"github.com/the-laziest/namadexer-go/internal/config"
 	"github.com/the-laziest/namadexer-go/internal/indexer"
+	"github.com/the-laziest/namadexer-go/internal/metrics"
 	"github.com/the-laziest/namadexer-go/internal/repository"
-	"github.com/the-laziest/namadexer-go/internal/repository/postgres"
+	"github.com/the-laziest/namadexer-go/internal/repository/database"
+	"github.com/the-laziest/namadexer-go/internal/repository/instrumented"
 	"github.com/the-laziest/namadexer-go/pkg/logger"
 )
 
 func main() {
 	time.Local = time.UTC
 
+	flag.Parse()
+
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
@@ -39,60 +44,93 @@ func main() {
 		logger.Fatal("Failed to parse config.toml", zap.Error(err))
 	}
 
+	// Replicas aren't used, the indexer reads rows it has just written
 	dbCfg := repository.Config{
+		Driver:            cfg.Database.Driver,
+		Path:              cfg.Database.Path,
 		Host:              cfg.Database.Host,
 		Port:              cfg.Database.Port,
 		User:              cfg.Database.User,
 		Password:          cfg.Database.Password,
 		DbName:            cfg.Database.DbName,
 		Schema:            cfg.ChainName,
-		CreateIndex:       cfg.Database.CreateIndex,
 		ConnectionTimeout: cfg.Database.ConnectionTimeout,
+		PartitionSize:     cfg.Database.PartitionSize,
 	}
 
-	repo, err := postgres.NewRepository(ctx, dbCfg)
+	repo, err := database.Open(ctx, dbCfg)
 	if err != nil {
 		logger.Fatal("Failed to init repository", zap.Error(err))
 	}
 
-	if err = repo.CreateTables(ctx); err != nil {
-		logger.Fatal("Failed to create database tables", zap.Error(err))
+	if flag.Arg(0) == "migrate" {
+		runMigrate(ctx, repo, flag.Args()[1:])
+		if closeErr := repo.Close(); closeErr != nil {
+			logger.Error("Closing repository failed", zap.Error(closeErr))
+		}
+		return
 	}
 
-	indexerCfg := indexer.Config{
-		RpcURL:             cfg.Indexer.RPC,
-		Checksums:          make(map[string]string),
-		WaitForBlock:       cfg.Indexer.WaitForBlock,
-		MaxBlocksInChannel: cfg.Indexer.MaxBlocksInChannel,
+	if _, err = repo.MigrateUp(ctx); err != nil {
+		logger.Fatal("Failed to migrate database", zap.Error(err))
 	}
 
-	bs, err := os.ReadFile("./checksums.json")
+	registry, err := checksums.New(cfg.Checksums.Registry())
 	if err != nil {
-		logger.Fatal("Failed to read checksums file", zap.Error(err))
+		logger.Fatal("Failed to load checksums", zap.Error(err))
 	}
-	rawChecksums := make(map[string]string)
-	err = json.Unmarshal(bs, &rawChecksums)
-	if err != nil {
-		logger.Fatal("Failed to decode checksums file", zap.Error(err))
+
+	indexerCfg := indexer.Config{
+		Source:              cfg.Indexer.Source,
+		SourceDir:           cfg.Indexer.SourceDir,
+		RpcURLs:             cfg.Indexer.RPCEndpoints(),
+		HealthCheckInterval: cfg.Indexer.HealthCheckInterval,
+		MaxHeightLag:        cfg.Indexer.MaxHeightLag,
+		Checksums:           registry,
+		WaitForBlock:        cfg.Indexer.WaitForBlock,
+		MaxBlocksInChannel:  cfg.Indexer.MaxBlocksInChannel,
+		FetchWorkers:        cfg.Indexer.FetchWorkers,
+		FetchWindow:         cfg.Indexer.FetchWindow,
+		BulkBatchSize:       cfg.Indexer.BulkBatchSize,
+		StoreRawTxs:         cfg.Indexer.StoreRawTxs,
+		RetainBlocks:        cfg.Indexer.RetainBlocks,
+		RetainDays:          cfg.Indexer.RetainDays,
+		PruneInterval:       cfg.Indexer.PruneInterval,
+		DecodeFailurePolicy: cfg.Indexer.OnDecodeFailure,
+		MaxRollbackDepth:    cfg.Indexer.MaxRollbackDepth,
 	}
-	indexerCfg.Checksums = prepareChecksums(rawChecksums)
 
-	indexer, err := indexer.New(indexerCfg, repo)
+	indexer, err := indexer.New(indexerCfg, instrumented.New(repo))
 	if err != nil {
 		logger.Fatal("Indexer init failed", zap.Error(err))
 	}
 
+	if command := flag.Arg(0); command != "" {
+		runCommand(ctx, indexer, command, flag.Args()[1:])
+		indexer.Close()
+		if closeErr := repo.Close(); closeErr != nil {
+			logger.Error("Closing repository failed", zap.Error(closeErr))
+		}
+		return
+	}
+
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	metricsServer := metrics.Serve(metrics.Config{Host: cfg.Prometheus.Host, Port: cfg.Prometheus.Port})
+
+	go registry.ReloadOnHangup(ctx)
+
 	logger.Info("Indexer starting...")
 
 	go func() {
 		err := indexer.Start(ctx)
 		if err != nil {
 			logger.Error("Indexer run failed", zap.Error(err))
-			interrupt <- os.Interrupt
+		} else {
+			logger.Info("Indexer stopped")
 		}
+		interrupt <- os.Interrupt
 	}()
 
 	<-interrupt
@@ -101,15 +139,88 @@ func main() {
 	logger.Info("Gracefully closing...")
 
 	indexer.Close()
+	if metricsServer != nil {
+		if closeErr := metricsServer.Close(); closeErr != nil {
+			logger.Error("Closing metrics server failed", zap.Error(closeErr))
+		}
+	}
 	if closeErr := repo.Close(); closeErr != nil {
 		logger.Error("Closing repository failed", zap.Error(closeErr))
 	}
 }
 
-func prepareChecksums(raw map[string]string) map[string]string {
-	checksums := make(map[string]string, len(raw))
-	for txType, hash := range raw {
-		checksums[strings.Split(hash, ".")[1]] = strings.Split(txType, ".")[0]
+func runCommand(ctx context.Context, indexer *indexer.Indexer, command string, args []string) {
+	var err error
+
+	switch command {
+	case "repair-wrappers":
+		err = indexer.RepairWrapperIDs(ctx)
+	case "retry-failed":
+		err = indexer.RetryFailedTxs(ctx)
+	case "prune":
+		err = indexer.Prune(ctx)
+	case "reindex":
+		flags := flag.NewFlagSet(command, flag.ExitOnError)
+		from := flags.Int64("from", 0, "first height to reindex")
+		to := flags.Int64("to", 0, "last height to reindex")
+		_ = flags.Parse(args)
+		err = indexer.Reindex(ctx, *from, *to)
+	case "redecode":
+		flags := flag.NewFlagSet(command, flag.ExitOnError)
+		from := flags.Int64("from", 1, "first height to redecode")
+		to := flags.Int64("to", 0, "last height to redecode, last indexed height by default")
+		_ = flags.Parse(args)
+		err = indexer.Redecode(ctx, *from, *to)
+	case "dump":
+		flags := flag.NewFlagSet(command, flag.ExitOnError)
+		from := flags.Int64("from", 0, "first height to dump")
+		to := flags.Int64("to", 0, "last height to dump")
+		dir := flags.String("dir", "", "directory to write block files to")
+		_ = flags.Parse(args)
+		err = indexer.Dump(ctx, *from, *to, *dir)
+	default:
+		logger.Fatal("Unknown command", zap.String("command", command))
+	}
+
+	if err != nil {
+		logger.Fatal("Command failed", zap.String("command", command), zap.Error(err))
+	}
+}
+
+func runMigrate(ctx context.Context, migrator repository.Migrator, args []string) {
+	if len(args) == 0 {
+		logger.Fatal("Migrate direction is required: up, down or status")
+	}
+
+	var err error
+
+	switch direction := args[0]; direction {
+	case "up":
+		var applied int
+		applied, err = migrator.MigrateUp(ctx)
+		if err == nil {
+			logger.Info("Migrations applied", zap.Int("count", applied))
+		}
+	case "down":
+		flags := flag.NewFlagSet("migrate down", flag.ExitOnError)
+		steps := flags.Int("steps", 1, "number of migrations to revert")
+		_ = flags.Parse(args[1:])
+		err = migrator.MigrateDown(ctx, *steps)
+	case "status":
+		var migrations []repository.Migration
+		migrations, err = migrator.MigrationStatus(ctx)
+		for _, m := range migrations {
+			appliedAt := "pending"
+			if m.AppliedAt != nil {
+				appliedAt = m.AppliedAt.Format(time.RFC3339)
+			}
+			logger.Info("Migration", zap.String("version", strconv.FormatInt(m.Version, 10)), zap.String("name", m.Name), zap.String("applied_at", appliedAt))
+		}
+	default:
+		logger.Fatal("Unknown migrate direction", zap.String("direction", direction))
+	}
+
+	if err != nil {
+		logger.Fatal("Migrate failed", zap.Error(err))
 	}
-	return checksums
 }
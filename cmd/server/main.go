@@ -2,19 +2,20 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"os"
 	"os/signal"
-	"strings"
 	"syscall"
 	"time"
 
 	"github.com/BurntSushi/toml"
 	"go.uber.org/zap"
 
+	"github.com/the-laziest/namadexer-go/internal/checksums"
 	"github.com/the-laziest/namadexer-go/internal/config"
+	"github.com/the-laziest/namadexer-go/internal/metrics"
 	"github.com/the-laziest/namadexer-go/internal/repository"
-	"github.com/the-laziest/namadexer-go/internal/repository/postgres"
+	"github.com/the-laziest/namadexer-go/internal/repository/database"
+	"github.com/the-laziest/namadexer-go/internal/repository/instrumented"
 	"github.com/the-laziest/namadexer-go/internal/server"
 	"github.com/the-laziest/namadexer-go/internal/service"
 	"github.com/the-laziest/namadexer-go/pkg/logger"
@@ -40,47 +41,63 @@ func main() {
 		logger.Fatal("Failed to parse config.toml", zap.Error(err))
 	}
 
-	bs, err := os.ReadFile("./checksums.json")
+	registry, err := checksums.New(cfg.Checksums.Registry())
 	if err != nil {
-		logger.Fatal("Failed to read checksums file", zap.Error(err))
+		logger.Fatal("Failed to load checksums", zap.Error(err))
 	}
-	rawChecksums := make(map[string]string)
-	err = json.Unmarshal(bs, &rawChecksums)
-	if err != nil {
-		logger.Fatal("Failed to decode checksums file", zap.Error(err))
-	}
-	checksums := prepareChecksums(rawChecksums)
 
 	dbCfg := repository.Config{
+		Driver:            cfg.Database.Driver,
+		Path:              cfg.Database.Path,
 		Host:              cfg.Database.Host,
 		Port:              cfg.Database.Port,
 		User:              cfg.Database.User,
 		Password:          cfg.Database.Password,
 		DbName:            cfg.Database.DbName,
 		Schema:            cfg.ChainName,
-		CreateIndex:       cfg.Database.CreateIndex,
 		ConnectionTimeout: cfg.Database.ConnectionTimeout,
+		PartitionSize:     cfg.Database.PartitionSize,
+		MaxReplicaLag:     cfg.Database.MaxReplicaLag,
+	}
+	for _, replica := range cfg.Database.Replicas {
+		dbCfg.Replicas = append(dbCfg.Replicas, repository.ReplicaConfig{
+			Host:     replica.Host,
+			Port:     replica.Port,
+			User:     replica.User,
+			Password: replica.Password,
+			DbName:   replica.DbName,
+		})
 	}
 
-	repo, err := postgres.NewRepository(ctx, dbCfg)
+	repo, err := database.Open(ctx, dbCfg)
 	if err != nil {
 		logger.Fatal("Failed to init repository", zap.Error(err))
 	}
 
-	service, err := service.New(repo, checksums)
+	if _, err = repo.MigrateUp(ctx); err != nil {
+		logger.Fatal("Failed to migrate database", zap.Error(err))
+	}
+
+	service, err := service.New(instrumented.New(repo), registry)
 	if err != nil {
 		logger.Fatal("Failed to init service", zap.Error(err))
 	}
 
 	serverCfg := server.Config{
-		Port: cfg.Server.Port,
+		Port:                 cfg.Server.Port,
+		HealthMaxLag:         cfg.Server.HealthMaxLag,
+		HealthStaleIntervals: cfg.Server.HealthStaleIntervals,
 	}
 
 	server := server.New(serverCfg, service)
 
+	metricsServer := metrics.Serve(metrics.Config{Host: cfg.Prometheus.Host, Port: cfg.Prometheus.Port})
+
 	interrupt := make(chan os.Signal, 1)
 	signal.Notify(interrupt, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 
+	go registry.ReloadOnHangup(ctx)
+
 	go func() {
 		if err := server.Start(); err != nil {
 			logger.Error("Serving failed", zap.Error(err))
@@ -99,15 +116,12 @@ func main() {
 	if closeErr := server.Close(closeCtx); closeErr != nil {
 		logger.Error("Closing server failed", zap.Error(closeErr))
 	}
+	if metricsServer != nil {
+		if closeErr := metricsServer.Shutdown(closeCtx); closeErr != nil {
+			logger.Error("Closing metrics server failed", zap.Error(closeErr))
+		}
+	}
 	if closeErr := repo.Close(); closeErr != nil {
 		logger.Error("Closing repository failed", zap.Error(closeErr))
 	}
 }
-
-func prepareChecksums(raw map[string]string) map[string]string {
-	checksums := make(map[string]string, len(raw))
-	for txType, hash := range raw {
-		checksums[strings.Split(txType, ".")[0]] = strings.Split(hash, ".")[1]
-	}
-	return checksums
-}
@@ -0,0 +1,220 @@
+package checksums
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const (
+	DefaultFile = "./checksums.json"
+
+	undefinedTxType = "undefined"
+)
+
+// Version is a set of checksum files which is active starting from the Height.
+type Version struct {
+	Height int64
+	Files  []string
+}
+
+type Config struct {
+	Versions []Version
+}
+
+type version struct {
+	height int64
+	byHash map[string]string
+	byType map[string]string
+}
+
+// Registry maps WASM code hashes to tx types for every configured Namada version.
+type Registry struct {
+	config Config
+
+	mu          sync.RWMutex
+	versions    []version
+	fingerprint string
+}
+
+func New(config Config) (*Registry, error) {
+	if len(config.Versions) == 0 {
+		config.Versions = []Version{{Height: 0, Files: []string{DefaultFile}}}
+	}
+
+	r := &Registry{config: config}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload reads all checksum files again. Current checksums are kept if any file fails to load.
+func (r *Registry) Reload() error {
+	versions := make([]version, 0, len(r.config.Versions))
+
+	for _, cfgVersion := range r.config.Versions {
+		if len(cfgVersion.Files) == 0 {
+			return errors.Create(fmt.Sprintf("no checksum files for version at height %d", cfgVersion.Height))
+		}
+
+		v := version{
+			height: cfgVersion.Height,
+			byHash: make(map[string]string),
+			byType: make(map[string]string),
+		}
+		// Later files override earlier ones
+		for _, file := range cfgVersion.Files {
+			if err := v.load(file); err != nil {
+				return errors.New(err, "Load checksums file", file)
+			}
+		}
+		versions = append(versions, v)
+	}
+
+	sort.SliceStable(versions, func(i, j int) bool {
+		return versions[i].height < versions[j].height
+	})
+
+	fingerprint := sha256.New()
+	for _, v := range versions {
+		txTypes := make([]string, 0, len(v.byType))
+		for txType := range v.byType {
+			txTypes = append(txTypes, txType)
+		}
+		sort.Strings(txTypes)
+		fmt.Fprintf(fingerprint, "%d;", v.height)
+		for _, txType := range txTypes {
+			fmt.Fprintf(fingerprint, "%s=%s;", txType, v.byType[txType])
+		}
+	}
+
+	r.mu.Lock()
+	r.versions = versions
+	r.fingerprint = hex.EncodeToString(fingerprint.Sum(nil))[:16]
+	r.mu.Unlock()
+
+	return nil
+}
+
+func (v *version) load(file string) error {
+	bs, err := os.ReadFile(file)
+	if err != nil {
+		return errors.New(err, "Read file")
+	}
+
+	raw := make(map[string]string)
+	if err = json.Unmarshal(bs, &raw); err != nil {
+		return errors.New(err, "Decode file")
+	}
+
+	for wasmName, wasmFile := range raw {
+		parts := strings.Split(wasmFile, ".")
+		if len(parts) < 2 {
+			return errors.Create(fmt.Sprintf("invalid checksum %s: %s", wasmName, wasmFile))
+		}
+		txType, hash := strings.Split(wasmName, ".")[0], strings.ToLower(parts[1])
+
+		if prevHash, ok := v.byType[txType]; ok {
+			delete(v.byHash, prevHash)
+		}
+		v.byType[txType] = hash
+		v.byHash[hash] = txType
+	}
+
+	return nil
+}
+
+// TxType returns tx type of the code hash at the height. Checksums of the version active at the height
+// are checked first, then all other versions, so txs with code of another version are still recognized.
+func (r *Registry) TxType(height int64, codeHash string) string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	active := r.activeVersion(height)
+	if active >= 0 {
+		if txType, ok := r.versions[active].byHash[codeHash]; ok {
+			return txType
+		}
+	}
+
+	for i := len(r.versions) - 1; i >= 0; i-- {
+		if txType, ok := r.versions[i].byHash[codeHash]; ok {
+			return txType
+		}
+	}
+
+	return undefinedTxType
+}
+
+// Hashes returns all known code hashes of the tx type across all versions.
+func (r *Registry) Hashes(txType string) []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var hashes []string
+	seen := make(map[string]struct{})
+	for _, v := range r.versions {
+		hash, ok := v.byType[txType]
+		if !ok {
+			continue
+		}
+		if _, ok = seen[hash]; ok {
+			continue
+		}
+		seen[hash] = struct{}{}
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// Fingerprint identifies the set of loaded checksums.
+func (r *Registry) Fingerprint() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.fingerprint
+}
+
+func (r *Registry) activeVersion(height int64) int {
+	active := -1
+	for i, v := range r.versions {
+		if v.height > height {
+			break
+		}
+		active = i
+	}
+	return active
+}
+
+// ReloadOnHangup reloads checksums every time the process receives SIGHUP until the context is done.
+func (r *Registry) ReloadOnHangup(ctx context.Context) {
+	hangup := make(chan os.Signal, 1)
+	signal.Notify(hangup, syscall.SIGHUP)
+	defer signal.Stop(hangup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hangup:
+			if err := r.Reload(); err != nil {
+				logger.Error("Reloading checksums failed", zap.Error(err))
+				continue
+			}
+			logger.Info("Checksums reloaded")
+		}
+	}
+}
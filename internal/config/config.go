@@ -1,34 +1,92 @@
 package config
 
+import "github.com/the-laziest/namadexer-go/internal/checksums"
+
 type Config struct {
 	ChainName  string           `toml:"chain_name"`
 	Database   DatabaseConfig   `toml:"database"`
 	Server     ServerConfig     `toml:"server"`
 	Indexer    IndexerConfig    `toml:"indexer"`
 	Prometheus PrometheusConfig `toml:"prometheus"`
+	Checksums  ChecksumsConfig  `toml:"checksums"`
 }
 
 type DatabaseConfig struct {
+	Driver            string `toml:"driver"`
+	Path              string `toml:"path"`
 	Host              string `toml:"host"`
 	Port              string `toml:"port"`
 	User              string `toml:"user"`
 	Password          string `toml:"password"`
 	DbName            string `toml:"db_name"`
-	CreateIndex       bool   `toml:"create_index"`
 	ConnectionTimeout int    `toml:"connection_timeout"`
+	PartitionSize     int64  `toml:"partition_size"`
+	MaxReplicaLag     int64  `toml:"max_replica_lag"`
+
+	Replicas []ReplicaConfig `toml:"replicas"`
+}
+
+type ReplicaConfig struct {
+	Host     string `toml:"host"`
+	Port     string `toml:"port"`
+	User     string `toml:"user"`
+	Password string `toml:"password"`
+	DbName   string `toml:"db_name"`
 }
 
 type ServerConfig struct {
-	Port string `toml:"port"`
+	Port                 string `toml:"port"`
+	HealthMaxLag         int64  `toml:"health_max_lag"`
+	HealthStaleIntervals int64  `toml:"health_stale_intervals"`
 }
 
 type IndexerConfig struct {
-	RPC                string `toml:"rpc"`
-	WaitForBlock       int64  `toml:"wait_for_block"`
-	MaxBlocksInChannel int64  `toml:"max_blocks_in_channel"`
+	Source              string   `toml:"source"`
+	SourceDir           string   `toml:"source_dir"`
+	RPC                 string   `toml:"rpc"`
+	RPCs                []string `toml:"rpcs"`
+	HealthCheckInterval int64    `toml:"health_check_interval"`
+	MaxHeightLag        int64    `toml:"max_height_lag"`
+	WaitForBlock        int64    `toml:"wait_for_block"`
+	MaxBlocksInChannel  int64    `toml:"max_blocks_in_channel"`
+	FetchWorkers        int      `toml:"fetch_workers"`
+	FetchWindow         int      `toml:"fetch_window"`
+	BulkBatchSize       int      `toml:"bulk_batch_size"`
+	StoreRawTxs         bool     `toml:"store_raw_txs"`
+	RetainBlocks        int64    `toml:"retain_blocks"`
+	RetainDays          int64    `toml:"retain_days"`
+	PruneInterval       int64    `toml:"prune_interval"`
+	OnDecodeFailure     string   `toml:"on_decode_failure"`
+	MaxRollbackDepth    int64    `toml:"max_rollback_depth"`
 }
 
 type PrometheusConfig struct {
 	Host string `toml:"host"`
 	Port string `toml:"port"`
 }
+
+type ChecksumsConfig struct {
+	Versions []ChecksumsVersionConfig `toml:"versions"`
+}
+
+type ChecksumsVersionConfig struct {
+	Height int64    `toml:"height"`
+	Files  []string `toml:"files"`
+}
+
+func (c ChecksumsConfig) Registry() checksums.Config {
+	registry := checksums.Config{}
+	for _, version := range c.Versions {
+		registry.Versions = append(registry.Versions, checksums.Version{Height: version.Height, Files: version.Files})
+	}
+	return registry
+}
+
+// RPCEndpoints returns all configured RPC endpoints, the single rpc field goes first.
+func (c IndexerConfig) RPCEndpoints() []string {
+	endpoints := make([]string, 0, len(c.RPCs)+1)
+	if c.RPC != "" {
+		endpoints = append(endpoints, c.RPC)
+	}
+	return append(endpoints, c.RPCs...)
+}
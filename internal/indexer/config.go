@@ -1,9 +1,40 @@
 package indexer
 
+import "github.com/the-laziest/namadexer-go/internal/checksums"
+
 type Config struct {
-	RpcURL    string
-	Checksums map[string]string
+	Source    string
+	SourceDir string
+	RpcURLs   []string
+
+	HealthCheckInterval int64
+	MaxHeightLag        int64
+	Checksums           *checksums.Registry
 
 	WaitForBlock       int64
 	MaxBlocksInChannel int64
+	FetchWorkers       int
+	FetchWindow        int
+	BulkBatchSize      int
+
+	StoreRawTxs bool
+
+	// Blocks older than RetainBlocks blocks or RetainDays days are pruned every PruneInterval seconds,
+	// 0 keeps all blocks.
+	RetainBlocks  int64
+	RetainDays    int64
+	PruneInterval int64
+
+	DecodeFailurePolicy string
+
+	// MaxRollbackDepth is the maximum number of stored blocks which can be rolled back after a chain
+	// divergence, 0 allows rolling back down to the earliest stored block.
+	MaxRollbackDepth int64
 }
+
+const (
+	// DecodeFailureHalt stops the indexer on the first tx which can't be processed.
+	DecodeFailureHalt = "halt"
+	// DecodeFailureQuarantine saves the block without failed txs and records them separately.
+	DecodeFailureQuarantine = "quarantine"
+)
@@ -0,0 +1,51 @@
+package indexer
+
+import (
+	"context"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// Dump writes blocks in [fromHeight, toHeight] with their results to dir in the format replayed by the file source.
+func (i *Indexer) Dump(ctx context.Context, fromHeight, toHeight int64, dir string) error {
+	if fromHeight < 1 || fromHeight > toHeight {
+		return ErrInvalidRange
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return errors.New(err, "Create dump dir")
+	}
+
+	logger.Info("Dumping blocks", zap.Int64("from", fromHeight), zap.Int64("to", toHeight), zap.String("dir", dir))
+
+	fetchCtx, cancelFetch := context.WithCancel(ctx)
+	defer cancelFetch()
+
+	blockChan := make(chan blockInfo, i.config.MaxBlocksInChannel)
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		defer close(blockChan)
+		i.blockFetcher(fetchCtx, fromHeight, toHeight, blockChan)
+	}()
+
+	dumped := int64(0)
+	for blockInfo := range blockChan {
+		if err := writeBlockFiles(dir, blockInfo); err != nil {
+			return errors.New(err, "Write block files")
+		}
+		dumped++
+	}
+
+	if dumped != toHeight-fromHeight+1 {
+		return errors.Create("Fetching blocks interrupted")
+	}
+
+	logger.Info("Dumping finished", zap.Int64("from", fromHeight), zap.Int64("to", toHeight))
+
+	return nil
+}
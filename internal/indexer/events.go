@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"encoding/hex"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+// getBlockEvents collects all events of the block in execution order: begin block, txs results and end block.
+// Events are linked with a tx by their "hash" attribute.
+func (i *Indexer) getBlockEvents(blockID bytes.HexBytes, height int64, resultBlockResults *coretypes.ResultBlockResults) []repository.BlockEvent {
+	events := make([]repository.BlockEvent, 0, len(resultBlockResults.BeginBlockEvents)+len(resultBlockResults.EndBlockEvents))
+
+	add := func(kind string, blockEvents []abci.Event) {
+		for _, event := range blockEvents {
+			rEvent := repository.BlockEvent{
+				BlockID:     blockID,
+				BlockHeight: height,
+				Kind:        kind,
+				EventPos:    int64(len(events)),
+				Type:        event.Type,
+				Attributes:  make([]repository.EventAttribute, 0, len(event.Attributes)),
+			}
+			for _, attr := range event.Attributes {
+				rEvent.Attributes = append(rEvent.Attributes, repository.EventAttribute{Key: attr.Key, Value: attr.Value})
+				if attr.Key == "hash" {
+					if txHash, err := hex.DecodeString(attr.Value); err == nil {
+						rEvent.TxHash = txHash
+					}
+				}
+			}
+			events = append(events, rEvent)
+		}
+	}
+
+	add(repository.EventKindBeginBlock, resultBlockResults.BeginBlockEvents)
+	for _, txResult := range resultBlockResults.TxsResults {
+		if txResult != nil {
+			add(repository.EventKindTx, txResult.Events)
+		}
+	}
+	add(repository.EventKindEndBlock, resultBlockResults.EndBlockEvents)
+
+	return events
+}
@@ -0,0 +1,230 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+func (i *Indexer) newFailedTx(blockID bytes.HexBytes, height, txPos int64, txRawData []byte, err error) repository.FailedTx {
+	failedTx := repository.FailedTx{
+		BlockID:     blockID,
+		BlockHeight: height,
+		TxPos:       txPos,
+		Data:        txRawData,
+		Error:       err.Error(),
+		CreatedAt:   time.Now(),
+	}
+
+	if tx, decodeErr := i.decodeTxRawData(txRawData); decodeErr == nil {
+		failedTx.TxType = tx.Type()
+		if txHash, hashErr := tx.GetHash(); hashErr == nil {
+			failedTx.TxHash = txHash[:]
+		}
+	}
+
+	return failedTx
+}
+
+// getBlockFailedTxs returns failed txs of the block at the given height ordered by position in block. Types of txs
+// quarantined before types were stored are taken from their data.
+func (i *Indexer) getBlockFailedTxs(ctx context.Context, repo repository.Repository, height int64) ([]repository.FailedTx, error) {
+	failedTxs, err := repo.GetBlockFailedTxs(ctx, height)
+	if err != nil {
+		return nil, errors.New(err, "Get block failed txs")
+	}
+
+	for n, failedTx := range failedTxs {
+		if failedTx.TxType != "" {
+			continue
+		}
+		if tx, err := i.decodeTxRawData(failedTx.Data); err == nil {
+			failedTxs[n].TxType = tx.Type()
+		}
+	}
+
+	return failedTxs, nil
+}
+
+// RetryFailedTxs processes quarantined txs again from their stored raw data without requesting blocks from the node,
+// return codes and gas used are taken from stored events. Processed txs are saved and removed from quarantine and
+// decrypted txs of the next block get ids of retried wrappers. Txs which still can't be processed stay quarantined.
+func (i *Indexer) RetryFailedTxs(ctx context.Context) error {
+	heights, err := i.repository.GetFailedTxHeights(ctx)
+	if err != nil {
+		return errors.New(err, "Get failed tx heights")
+	}
+
+	logger.Info("Retrying failed txs", zap.Int("blocks", len(heights)))
+
+	retried := 0
+
+	for _, height := range heights {
+		err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+			cnt, err := i.retryBlockFailedTxs(txCtx, repo, height)
+			retried += cnt
+			return err
+		})
+		if err != nil {
+			return errors.New(err, "Retry block failed txs")
+		}
+
+		logger.Info("Block failed txs retried", zap.Int64("height", height))
+	}
+
+	logger.Info("Retrying failed txs finished", zap.Int("txs", retried))
+
+	return nil
+}
+
+// retryBlockFailedTxs processes failed txs of the block at the given height and returns the number of txs
+// removed from quarantine.
+func (i *Indexer) retryBlockFailedTxs(ctx context.Context, repo repository.Repository, height int64) (int, error) {
+	block, err := repo.GetBlockBy(ctx, repository.BlockFilter{Height: height})
+	if err != nil {
+		return 0, errors.New(err, "Get block")
+	}
+
+	storedTxs, err := repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID, Height: height})
+	if err != nil {
+		return 0, errors.New(err, "Get stored txs")
+	}
+	stored := make(map[int64]repository.Transaction, len(storedTxs))
+	for _, tx := range storedTxs {
+		stored[tx.PosInBlock] = tx
+	}
+
+	failedTxs, err := i.getBlockFailedTxs(ctx, repo, height)
+	if err != nil {
+		return 0, err
+	}
+
+	events, err := repo.GetBlockEvents(ctx, repository.EventFilter{FromHeight: height, ToHeight: height})
+	if err != nil {
+		return 0, errors.New(err, "Get block events")
+	}
+
+	prevBlock, err := i.loadProcessedBlock(ctx, repo, height-1)
+	if err != nil {
+		return 0, errors.New(err, "Load previous block wrappers")
+	}
+
+	resultBlockResults := &coretypes.ResultBlockResults{Height: height, EndBlockEvents: endBlockEvents(events)}
+	indexes := decryptedIndexes(storedTxs, failedTxs)
+
+	var (
+		data           blockData
+		retried        []int64
+		retriedWrapper bool
+	)
+
+	for _, failedTx := range failedTxs {
+		// Only the payload of a stored tx is quarantined, the tx is updated if its payload is processed now
+		if storedTx, ok := stored[failedTx.TxPos]; ok {
+			payload, rows, err := i.retryPayload(height, failedTx)
+			if err != nil {
+				logger.Warn("Failed tx payload can't be processed", zap.Int64("height", height), zap.Int64("tx_id", failedTx.TxPos), zap.Error(err))
+				continue
+			}
+			if err = updateTxPayload(ctx, repo, height, storedTx.Hash, payload, rows); err != nil {
+				return 0, errors.New(err, "Update tx payload")
+			}
+			data.maspTxs = append(data.maspTxs, rows.maspTxs...)
+			data.maspAssetData = append(data.maspAssetData, rows.maspAssetData...)
+			retried = append(retried, failedTx.TxPos)
+			continue
+		}
+
+		decryptedID := indexes[failedTx.TxPos]
+		tx, rows, err := i.processTx(block.BlockID, height, failedTx.TxPos, &decryptedID, failedTx.Data, resultBlockResults, prevBlock)
+		var pErr payloadError
+		if err != nil && !errors.As(err, &pErr) {
+			logger.Warn("Failed tx can't be processed", zap.Int64("height", height), zap.Int64("tx_id", failedTx.TxPos), zap.Error(err))
+			continue
+		}
+
+		// A tx whose payload still fails is saved without data and stays quarantined
+		data.txs = append(data.txs, tx)
+		data.accountTxs = append(data.accountTxs, rows.accountTxs...)
+		data.ibcTransfers = append(data.ibcTransfers, rows.ibcTransfers...)
+		data.maspTxs = append(data.maspTxs, rows.maspTxs...)
+		data.maspAssetData = append(data.maspAssetData, rows.maspAssetData...)
+		if i.config.StoreRawTxs {
+			rawTx, err := newRawTx(tx.Hash, block.BlockID, height, failedTx.TxPos, failedTx.Data)
+			if err != nil {
+				return 0, errors.New(err, "Compress raw tx")
+			}
+			data.rawTxs = append(data.rawTxs, rawTx)
+		}
+		if err == nil {
+			retried = append(retried, failedTx.TxPos)
+		}
+		retriedWrapper = retriedWrapper || tx.TxType == "Wrapper"
+	}
+
+	data.maspAssets, err = i.maspAssets.resolve(ctx, repo, data.maspAssetData, data.maspTxs)
+	if err != nil {
+		return 0, errors.New(err, "Resolve MASP assets")
+	}
+	if err = saveDataRows(ctx, repo, data); err != nil {
+		return 0, errors.New(err, "Save txs")
+	}
+
+	for _, txPos := range retried {
+		if err = repo.DeleteFailedTx(ctx, height, txPos); err != nil {
+			return 0, errors.New(err, "Delete failed tx")
+		}
+	}
+
+	if retriedWrapper {
+		if _, err = i.repairBlockWrapperIDs(ctx, repo, height+1); err != nil {
+			return 0, errors.New(err, "Repair next block wrapper ids")
+		}
+	}
+
+	return len(retried), nil
+}
+
+// retryPayload processes the payload of a quarantined decrypted tx which is stored without data.
+func (i *Indexer) retryPayload(height int64, failedTx repository.FailedTx) ([]byte, txRows, error) {
+	tx, err := i.decodeTxRawData(failedTx.Data)
+	if err != nil {
+		return nil, txRows{}, errors.New(err, "Decode tx raw data")
+	}
+
+	tx.BlockHeight = height
+	tx.TxPos = failedTx.TxPos
+	copy(tx.TxHash[:], failedTx.TxHash)
+
+	codeHash, err := tx.GetCodeHash()
+	if err != nil {
+		return nil, txRows{}, errors.New(err, "Get code hash")
+	}
+	tx.DecryptedTxType = i.decryptedTxType(height, codeHash)
+
+	return i.processSuccessTx(tx)
+}
+
+// endBlockEvents restores end block events from stored events, so return codes and gas used of txs can be found.
+func endBlockEvents(events []repository.BlockEvent) []abci.Event {
+	var endBlock []abci.Event
+	for _, event := range events {
+		if event.Kind != repository.EventKindEndBlock {
+			continue
+		}
+		abciEvent := abci.Event{Type: event.Type, Attributes: make([]abci.EventAttribute, 0, len(event.Attributes))}
+		for _, attr := range event.Attributes {
+			abciEvent.Attributes = append(abciEvent.Attributes, abci.EventAttribute{Key: attr.Key, Value: attr.Value})
+		}
+		endBlock = append(endBlock, abciEvent)
+	}
+	return endBlock
+}
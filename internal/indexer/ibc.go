@@ -0,0 +1,57 @@
+package indexer
+
+import (
+	"github.com/tendermint/tendermint/libs/bytes"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/internal/types/ibc"
+	"github.com/the-laziest/namadexer-go/pkg/borsh"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// processIbcTx decodes the IBC message of tx_ibc data and adds sender and receiver of its token transfer
+// to account txs and the MASP transaction of shielded transfers to MASP txs. Data which can't be decoded
+// is kept as hex, so unknown messages don't stop indexing.
+func (i *Indexer) processIbcTx(tx types.Tx, data []byte, accTxs *accountTxs, maspTxs *maspTxs) (any, []repository.IbcTransfer) {
+	msg, err := ibc.Decode(data)
+	if err != nil {
+		logger.Warn("Decode IBC message failed", zap.Int64("height", tx.BlockHeight), zap.Int64("tx_id", tx.TxPos), zap.Error(err))
+		return bytes.HexBytes(data), nil
+	}
+
+	if len(msg.ShieldedTransfer) > 0 {
+		var shielded types.IbcShieldedTransfer
+		if err = borsh.Deserialize(&shielded, msg.ShieldedTransfer); err != nil {
+			logger.Warn("Decode IBC shielded transfer failed", zap.Int64("height", tx.BlockHeight), zap.Int64("tx_id", tx.TxPos), zap.Error(err))
+		} else {
+			maspTxs.add(shielded.MaspTx)
+		}
+	}
+
+	transfer, ok := msg.Transfer()
+	if !ok {
+		return msg, nil
+	}
+
+	accTxs.addString(repository.RoleSource, transfer.Sender)
+	accTxs.addString(repository.RoleTarget, transfer.Receiver)
+
+	return msg, []repository.IbcTransfer{{
+		TxHash:             tx.TxHash[:],
+		BlockHeight:        tx.BlockHeight,
+		TxPos:              tx.TxPos,
+		MsgType:            msg.Type,
+		SourcePort:         transfer.SourcePort,
+		SourceChannel:      transfer.SourceChannel,
+		DestinationPort:    transfer.DestinationPort,
+		DestinationChannel: transfer.DestinationChannel,
+		Sequence:           int64(transfer.Sequence),
+		Denom:              transfer.Denom,
+		Amount:             transfer.Amount,
+		Sender:             transfer.Sender,
+		Receiver:           transfer.Receiver,
+		Memo:               transfer.Memo,
+	}}
+}
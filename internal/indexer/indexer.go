@@ -1,20 +1,22 @@
 package indexer
 
 import (
+	gobytes "bytes"
 	"context"
 	"encoding/json"
+	"slices"
 	"strconv"
 	"strings"
-	"time"
+	"sync"
+	"sync/atomic"
 
 	"github.com/tendermint/tendermint/libs/bytes"
-	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
 	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
-	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
 	tmtypes "github.com/tendermint/tendermint/types"
 	"go.uber.org/zap"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/the-laziest/namadexer-go/internal/metrics"
 	"github.com/the-laziest/namadexer-go/internal/repository"
 	"github.com/the-laziest/namadexer-go/internal/types"
 	ptypes "github.com/the-laziest/namadexer-go/internal/types/proto"
@@ -29,135 +31,372 @@ type blockInfo struct {
 }
 
 type processedBlock struct {
-	height int64
-	txs    []repository.Transaction
+	height   int64
+	wrappers [][]byte
 }
 
 type Indexer struct {
 	config Config
 
-	client *rpchttp.HTTP
+	source BlockSource
 
-	blockChan chan blockInfo
 	lastBlock processedBlock
 
 	repository repository.Repository
+
+	chainHeight   atomic.Int64
+	indexedHeight atomic.Int64
+	lastBlockTime atomic.Int64
+	errorCount    atomic.Int64
+	syncState     atomic.Value
+	rate          rateMeter
+
+	maspAssets maspAssetRegistry
+
+	wg sync.WaitGroup
 }
 
 func New(config Config, repository repository.Repository) (*Indexer, error) {
-	client, err := rpchttp.New(config.RpcURL)
+	source, err := newBlockSource(config)
 	if err != nil {
-		return nil, errors.New(err, "Init client")
+		return nil, errors.New(err, "Init block source")
+	}
+	return NewWithSource(config, source, repository), nil
+}
+
+// NewWithSource creates an indexer which receives blocks from the given source.
+func NewWithSource(config Config, source BlockSource, repository repository.Repository) *Indexer {
+	if config.FetchWorkers <= 0 {
+		config.FetchWorkers = 1
+	}
+	if config.FetchWindow < config.FetchWorkers {
+		config.FetchWindow = config.FetchWorkers
 	}
 	return &Indexer{
 		config:     config,
-		client:     client,
-		blockChan:  make(chan blockInfo, config.MaxBlocksInChannel),
+		source:     source,
 		repository: repository,
-	}, nil
+	}
 }
 
+// Close waits until block fetching is stopped and closes the block source. The context passed to Start
+// must be cancelled before.
 func (i *Indexer) Close() {
-	close(i.blockChan)
+	i.wg.Wait()
+	if err := i.source.Close(); err != nil {
+		logger.Error("Closing block source failed", zap.Error(err))
+	}
 }
 
-var ErrBlockNotFound = errors.Create("Block not found")
+var (
+	ErrBlockNotFound = errors.Create("Block not found")
+	ErrChainDiverged = errors.Create("Chain diverged from stored blocks")
+	ErrForkNotFound  = errors.Create("No stored block matches the chain")
+)
+
+func (i *Indexer) Start(ctx context.Context) (err error) {
+	i.setSyncState(repository.SyncStateStarting)
+	defer func() { i.saveFinalStatus(err) }()
+
+	i.wg.Add(2)
+	go func() {
+		defer i.wg.Done()
+		i.trackChainHeight(ctx)
+	}()
+	go func() {
+		defer i.wg.Done()
+		i.reportStatus(ctx)
+	}()
+	if i.pruningEnabled() {
+		i.wg.Add(1)
+		go func() {
+			defer i.wg.Done()
+			i.pruneOldBlocks(ctx)
+		}()
+	}
+
+	for {
+		lastSavedHeight, err := i.repository.GetLastHeight(ctx)
+		if err != nil {
+			return errors.New(err, "Get last height")
+		}
+		i.indexedHeight.Store(lastSavedHeight)
+
+		if err = i.repository.EnsurePartitions(ctx, lastSavedHeight); err != nil {
+			return errors.New(err, "Ensure partitions")
+		}
+
+		i.lastBlock, err = i.loadProcessedBlock(ctx, i.repository, lastSavedHeight)
+		if err != nil {
+			return errors.New(err, "Load last block wrappers")
+		}
+
+		i.setSyncState(repository.SyncStateSyncing)
+
+		fetchCtx, cancelFetch := context.WithCancel(ctx)
+		blockChan := make(chan blockInfo, i.config.MaxBlocksInChannel)
+
+		i.wg.Add(1)
+		go func() {
+			defer i.wg.Done()
+			defer close(blockChan)
+			i.blockFetcher(fetchCtx, lastSavedHeight+1, 0, blockChan)
+		}()
 
-func (i *Indexer) Start(ctx context.Context) error {
+		err = i.startBlockProcessor(ctx, blockChan)
+		cancelFetch()
+		for range blockChan {
+		}
+
+		if !errors.Is(err, ErrChainDiverged) {
+			return errors.New(err, "Block processor")
+		}
+
+		logger.Warn("Chain divergence detected", zap.Error(err))
+		i.errorCount.Add(1)
+		i.setSyncState(repository.SyncStateRollback)
+
+		if err = i.rollback(ctx); err != nil {
+			return errors.New(err, "Rollback")
+		}
+	}
+}
+
+// rollback finds the highest stored block which is still present in the chain served by the node
+// and removes all stored data above it.
+func (i *Indexer) rollback(ctx context.Context) error {
 	lastSavedHeight, err := i.repository.GetLastHeight(ctx)
 	if err != nil {
 		return errors.New(err, "Get last height")
 	}
 
-	go i.blockFetcher(ctx, lastSavedHeight+1)
+	forkHeight, err := i.findForkHeight(ctx, lastSavedHeight)
+	if err != nil {
+		return errors.New(err, "Find fork height")
+	}
+
+	logger.Warn("Rolling back stored blocks", zap.Int64("fork_height", forkHeight), zap.Int64("last_height", lastSavedHeight))
 
-	err = i.startBlockProcessor(ctx)
+	err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+		return repo.DeleteBlocks(txCtx, forkHeight+1, lastSavedHeight)
+	})
 	if err != nil {
-		return errors.New(err, "Block processor")
+		return errors.New(err, "Delete blocks")
 	}
+	i.maspAssets.reset()
+
+	logger.Info("Rollback finished", zap.Int64("height", forkHeight))
 
 	return nil
 }
 
-func (i *Indexer) blockFetcher(ctx context.Context, startHeight int64) {
-	for height := startHeight; ; height++ {
+// findForkHeight searches the highest stored block matching the chain down to the earliest stored block,
+// but not deeper than MaxRollbackDepth blocks below fromHeight.
+func (i *Indexer) findForkHeight(ctx context.Context, fromHeight int64) (int64, error) {
+	lowestHeight, err := i.repository.GetEarliestHeight(ctx)
+	if err != nil {
+		return 0, errors.New(err, "Get earliest height")
+	}
+	if i.config.MaxRollbackDepth > 0 {
+		lowestHeight = max(lowestHeight, fromHeight-i.config.MaxRollbackDepth)
+	}
+
+	for height := fromHeight; height >= lowestHeight && height > 0; height-- {
+		stored, err := i.repository.GetBlockBy(ctx, repository.BlockFilter{Height: height})
+		if err == repository.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return 0, errors.New(err, "Get stored block")
+		}
+
+		resultBlock, err := i.source.Block(ctx, height)
+		if err != nil {
+			return 0, errors.New(err, "Get block")
+		}
+
+		if gobytes.Equal(stored.BlockID, resultBlock.BlockID.Hash) {
+			return height, nil
+		}
+
+		logger.Warn("Stored block differs from node", zap.Int64("height", height),
+			zap.String("stored_block_id", bytes.HexBytes(stored.BlockID).String()),
+			zap.String("node_block_id", resultBlock.BlockID.Hash.String()))
+	}
+	return 0, errors.New(ErrForkNotFound, "Search down to height "+strconv.FormatInt(lowestHeight, 10))
+}
+
+// blockFetcher requests blocks from startHeight up to endHeight (unbounded if endHeight is 0) by FetchWorkers
+// concurrent workers and pushes them to blockChan strictly in height order. At most FetchWindow heights
+// are requested or waiting for reordering at the same time.
+func (i *Indexer) blockFetcher(ctx context.Context, startHeight, endHeight int64, blockChan chan<- blockInfo) {
+	heights := make(chan int64)
+	results := make(chan blockInfo, i.config.FetchWindow)
+	window := make(chan struct{}, i.config.FetchWindow)
+
+	var workers sync.WaitGroup
+	for range i.config.FetchWorkers {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			i.fetchWorker(ctx, heights, results)
+		}()
+	}
+	workersDone := make(chan struct{})
+	go func() {
+		workers.Wait()
+		close(workersDone)
+	}()
+	defer func() { <-workersDone }()
+
+	go func() {
+		defer close(heights)
+		for height := startHeight; endHeight == 0 || height <= endHeight; height++ {
+			select {
+			case window <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case heights <- height:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	pending := make(map[int64]blockInfo, i.config.FetchWindow)
+	nextHeight := startHeight
+
+	exhausted := false
+
+	for {
 		select {
 		case <-ctx.Done():
 			logger.Error("Stopping block fecher", zap.Error(ctx.Err()))
 			return
-		default:
+		case info := <-results:
+			pending[info.resultBlock.Block.Height] = info
+		case <-workersDone:
+			// All workers stopped because the source is exhausted, push remaining blocks in order
+			exhausted = true
+			for len(results) > 0 {
+				info := <-results
+				pending[info.resultBlock.Block.Height] = info
+			}
+		}
+
+		for {
+			info, ok := pending[nextHeight]
+			if !ok {
+				break
+			}
+			select {
+			case blockChan <- info:
+			case <-ctx.Done():
+				logger.Error("Stopping block fecher", zap.Error(ctx.Err()))
+				return
+			}
+			delete(pending, nextHeight)
+			<-window
+			nextHeight++
+		}
+
+		if endHeight != 0 && nextHeight > endHeight {
+			return
+		}
+		if exhausted {
+			logger.Info("Block source exhausted", zap.Int64("height", nextHeight))
+			return
+		}
+	}
+}
+
+func (i *Indexer) fetchWorker(ctx context.Context, heights <-chan int64, results chan<- blockInfo) {
+	for height := range heights {
+		for attempt := 0; ; attempt++ {
 			blockInfo, err := i.getBlock(ctx, height)
-			if err != nil {
-				logger.Error("Get block info failed", zap.Error(err))
-				height--
-				time.Sleep(time.Second * time.Duration(i.config.WaitForBlock))
-				continue
+			if err == nil {
+				select {
+				case results <- blockInfo:
+				case <-ctx.Done():
+					return
+				}
+				break
+			}
+			if !errors.Is(err, ErrBlockNotFound) {
+				logger.Error("Get block info failed", zap.Int64("height", height), zap.Error(err))
+				i.errorCount.Add(1)
+			}
+			if err = i.source.WaitForHeight(ctx, height, attempt); err != nil {
+				return
 			}
-			i.blockChan <- blockInfo
 		}
 	}
 }
 
-func (i *Indexer) startBlockProcessor(ctx context.Context) error {
-	latestBlock, err := i.client.Block(ctx, nil)
+func (i *Indexer) startBlockProcessor(ctx context.Context, blockChan <-chan blockInfo) error {
+	latestHeight, err := i.source.LatestHeight(ctx)
 	if err != nil {
-		return errors.New(err, "Get latest block")
+		return errors.New(err, "Get latest height")
 	}
 
-	latestHeight := latestBlock.Block.Height
 	logger.Info("Latest block height on start: " + strconv.FormatInt(latestHeight, 10))
 
-	hasIndexes, err := i.repository.HasIndexes(ctx)
-	if err != nil {
-		return err
-	}
+	// Blocks far from the chain tip are saved in batches, near the tip every block is saved separately
+	var batch []blockData
 
-	for blockInfo := range i.blockChan {
+	for blockInfo := range blockChan {
+		height := blockInfo.resultBlock.Block.Height
 
-		logger.Info("Processing block", zap.Int64("height", blockInfo.resultBlock.Block.Height))
+		logger.Info("Processing block", zap.Int64("height", height))
 
-		err := i.processBlock(ctx, blockInfo.resultBlock, blockInfo.resultBlockResults)
+		data, err := i.prepareBlock(ctx, blockInfo, batch)
 		if err != nil {
 			return errors.New(err, "Process block failed")
 		}
+		batch = append(batch, data)
 
-		logger.Info("Block saved", zap.Int64("height", blockInfo.resultBlock.Block.Height))
+		if i.catchingUp(height) && len(batch) < i.config.BulkBatchSize {
+			continue
+		}
+
+		if err = i.saveBlocks(ctx, batch); err != nil {
+			return errors.New(err, "Save blocks failed")
+		}
+		batch = batch[:0]
 
-		if blockInfo.resultBlock.Block.Height == latestHeight {
+		if height == latestHeight {
 			logger.Info("Indexer synced")
-			if !hasIndexes {
-				err = i.repository.CreateIndexes(ctx)
-				if err != nil {
-					return err
-				}
-				logger.Info("Database indexes created")
-			}
 		}
 	}
+
+	// Blocks of the unfinished batch are fetched again after restart
+	if len(batch) != 0 && ctx.Err() == nil {
+		return errors.New(i.saveBlocks(ctx, batch), "Save blocks failed")
+	}
 	return nil
 }
 
-func (i *Indexer) checkNotFoundError(err error) error {
-	var rpcErr *rpctypes.RPCError
-	if errors.As(err, &rpcErr) {
-		if rpcErr.Code == -32603 {
-			return ErrBlockNotFound
-		}
+// catchingUp reports whether the height is far enough from the chain tip to save blocks in batches.
+func (i *Indexer) catchingUp(height int64) bool {
+	if i.config.BulkBatchSize <= 1 {
+		return false
 	}
-	return err
+	return i.chainHeight.Load()-height > int64(i.config.BulkBatchSize)
 }
 
 func (i *Indexer) getBlock(ctx context.Context, height int64) (blockInfo, error) {
 
 	logger.Info("Requesting block", zap.Int64("height", height))
 
-	resultBlock, err := i.client.Block(ctx, &height)
+	resultBlock, err := i.source.Block(ctx, height)
 	if err != nil {
-		return blockInfo{}, i.checkNotFoundError(errors.New(err, "Get block"))
+		return blockInfo{}, errors.New(err, "Get block")
 	}
-	resultBlockResults, err := i.client.BlockResults(ctx, &height)
+	resultBlockResults, err := i.source.BlockResults(ctx, height)
 	if err != nil {
-		return blockInfo{}, i.checkNotFoundError(errors.New(err, "Get block results"))
+		return blockInfo{}, errors.New(err, "Get block results")
 	}
 
 	logger.Info("Block info received", zap.Int64("height", height))
@@ -165,7 +404,84 @@ func (i *Indexer) getBlock(ctx context.Context, height int64) (blockInfo, error)
 	return blockInfo{resultBlock, resultBlockResults}, nil
 }
 
-func (i *Indexer) processBlock(ctx context.Context, resultBlock *coretypes.ResultBlock, resultBlockResults *coretypes.ResultBlockResults) error {
+// prepareBlock checks that the block links to the previous one and decodes it. pending contains blocks
+// which are decoded but not saved yet.
+func (i *Indexer) prepareBlock(ctx context.Context, info blockInfo, pending []blockData) (blockData, error) {
+	height := info.resultBlock.Block.Height
+	lastBlockID := info.resultBlock.Block.Header.LastBlockID.Hash
+
+	if len(pending) == 0 {
+		if err := i.checkParent(ctx, height, lastBlockID); err != nil {
+			return blockData{}, err
+		}
+	} else if !gobytes.Equal(lastBlockID, pending[len(pending)-1].block.BlockID) {
+		return blockData{}, ErrChainDiverged
+	}
+
+	data, err := i.buildBlockData(info.resultBlock, info.resultBlockResults, i.lastBlock)
+	if err != nil {
+		return blockData{}, err
+	}
+
+	data.maspAssets, err = i.maspAssets.resolve(ctx, i.repository, data.maspAssetData, data.maspTxs)
+	if err != nil {
+		return blockData{}, errors.New(err, "Resolve MASP assets")
+	}
+
+	i.lastBlock = processedBlock{
+		height:   height,
+		wrappers: wrapperHashes(data.txs, data.failedTxs),
+	}
+
+	return data, nil
+}
+
+// saveBlocks saves blocks in a single database transaction.
+func (i *Indexer) saveBlocks(ctx context.Context, datas []blockData) error {
+	err := i.repository.EnsurePartitions(ctx, datas[len(datas)-1].block.HeaderHeight)
+	if err != nil {
+		return errors.New(err, "Ensure partitions")
+	}
+
+	err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+		return saveBlocksData(txCtx, repo, datas...)
+	})
+	if err != nil {
+		return errors.New(err, "Save block info")
+	}
+
+	for _, data := range datas {
+		i.observeBlockSaved(data.block.HeaderHeight, data.block.HeaderTime)
+	}
+
+	if len(datas) == 1 {
+		logger.Info("Block saved", zap.Int64("height", datas[0].block.HeaderHeight))
+	} else {
+		logger.Info("Blocks saved", zap.Int64("from", datas[0].block.HeaderHeight), zap.Int64("to", datas[len(datas)-1].block.HeaderHeight))
+	}
+
+	return nil
+}
+
+// blockData contains all rows which are saved for a single block.
+type blockData struct {
+	block            repository.Block
+	commitSignatures []repository.CommitSignature
+	evidences        []repository.Evidence
+	events           []repository.BlockEvent
+	txs              []repository.Transaction
+	accountTxs       []repository.AccountTransaction
+	ibcTransfers     []repository.IbcTransfer
+	maspTxs          []repository.MaspTx
+	maspAssetData    []types.AssetData
+	maspAssets       []repository.MaspAsset
+	rawTxs           []repository.RawTx
+	failedTxs        []repository.FailedTx
+}
+
+// buildBlockData decodes block and its txs. prevBlock must contain wrappers of the previous block
+// to link decrypted txs with them.
+func (i *Indexer) buildBlockData(resultBlock *coretypes.ResultBlock, resultBlockResults *coretypes.ResultBlockResults, prevBlock processedBlock) (blockData, error) {
 
 	height := resultBlock.Block.Height
 
@@ -198,69 +514,184 @@ func (i *Indexer) processBlock(ctx context.Context, resultBlock *coretypes.Resul
 		CommitBlockIDPartsHeaderHash:      block.LastCommit.BlockID.PartSetHeader.Hash,
 	}
 
-	commitSignatures := i.getCommitSignatures(blockID, block.LastCommit.Signatures)
+	commitSignatures := i.getCommitSignatures(blockID, height, block.LastCommit.Signatures)
 	evidences := i.getEvidences(blockID, block.Evidence.Evidence)
+	events := i.getBlockEvents(blockID, height, resultBlockResults)
 
 	txs := make([]repository.Transaction, 0, len(block.Data.Txs))
 	accTxs := make([]repository.AccountTransaction, 0)
+	ibcTransfers := make([]repository.IbcTransfer, 0)
+	maspTxs := make([]repository.MaspTx, 0)
+	maspAssetData := make([]types.AssetData, 0)
+	rawTxs := make([]repository.RawTx, 0)
+	failedTxs := make([]repository.FailedTx, 0)
 	decryptedID := 0
 
 	for id, tx := range block.Data.Txs {
 
 		logger.Info("Processing tx", zap.Int64("height", height), zap.Int("tx_id", id))
 
-		tx, accTx, err := i.processTx(blockID, height, int64(id), &decryptedID, tx, resultBlockResults)
+		tx, rows, err := i.processTx(blockID, height, int64(id), &decryptedID, tx, resultBlockResults, prevBlock)
 		if err != nil {
 			logger.Error("Process tx failed", zap.Int64("height", height), zap.Int("tx_id", id), zap.Error(err))
-			return errors.New(err, "Process tx failed")
+			if i.config.DecodeFailurePolicy != DecodeFailureQuarantine {
+				return blockData{}, errors.New(err, "Process tx failed")
+			}
+
+			failedTx := i.newFailedTx(blockID, height, int64(id), block.Data.Txs[id], err)
+			if failedTx.TxType == "" {
+				// Decrypted txs of wrappers of the previous block precede other txs, so a tx which can't be decoded
+				// is taken for a decrypted tx while such wrappers remain and for a wrapper after them
+				if prevBlock.height == height-1 && decryptedID < len(prevBlock.wrappers) {
+					failedTx.TxType = "Decrypted"
+					decryptedID++
+				} else {
+					failedTx.TxType = "Wrapper"
+				}
+			}
+			failedTxs = append(failedTxs, failedTx)
+			metrics.QuarantinedTxs.Inc()
+			i.errorCount.Add(1)
+
+			// Only the payload is quarantined if the rest of the tx is processed
+			var pErr payloadError
+			if !errors.As(err, &pErr) {
+				continue
+			}
 		}
 
 		txs = append(txs, tx)
-		if accTx != nil {
-			accTxs = append(accTxs, *accTx)
+		accTxs = append(accTxs, rows.accountTxs...)
+		ibcTransfers = append(ibcTransfers, rows.ibcTransfers...)
+		maspTxs = append(maspTxs, rows.maspTxs...)
+		maspAssetData = append(maspAssetData, rows.maspAssetData...)
+
+		if i.config.StoreRawTxs {
+			rawTx, err := newRawTx(tx.Hash, blockID, height, int64(id), block.Data.Txs[id])
+			if err != nil {
+				return blockData{}, errors.New(err, "Compress raw tx")
+			}
+			rawTxs = append(rawTxs, rawTx)
 		}
 	}
 
-	err := i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
-		err := repo.AddBlock(txCtx, rBlock)
-		if err != nil {
-			return err
-		}
+	return blockData{
+		block:            rBlock,
+		commitSignatures: commitSignatures,
+		evidences:        evidences,
+		events:           events,
+		txs:              txs,
+		accountTxs:       accTxs,
+		ibcTransfers:     ibcTransfers,
+		maspTxs:          maspTxs,
+		maspAssetData:    maspAssetData,
+		rawTxs:           rawTxs,
+		failedTxs:        failedTxs,
+	}, nil
+}
 
-		err = repo.AddCommitSignatures(txCtx, commitSignatures...)
-		if err != nil {
+// saveBlocksData saves rows of all blocks at once, so bulk inserts can be used for many blocks.
+func saveBlocksData(ctx context.Context, repo repository.Repository, datas ...blockData) error {
+	var merged blockData
+	for _, data := range datas {
+		if err := repo.AddBlock(ctx, data.block); err != nil {
 			return err
 		}
+		merged.commitSignatures = append(merged.commitSignatures, data.commitSignatures...)
+		merged.evidences = append(merged.evidences, data.evidences...)
+		merged.events = append(merged.events, data.events...)
+		merged.txs = append(merged.txs, data.txs...)
+		merged.accountTxs = append(merged.accountTxs, data.accountTxs...)
+		merged.ibcTransfers = append(merged.ibcTransfers, data.ibcTransfers...)
+		merged.maspTxs = append(merged.maspTxs, data.maspTxs...)
+		merged.maspAssets = append(merged.maspAssets, data.maspAssets...)
+		merged.rawTxs = append(merged.rawTxs, data.rawTxs...)
+		merged.failedTxs = append(merged.failedTxs, data.failedTxs...)
+	}
 
-		err = repo.AddEvidences(txCtx, evidences...)
-		if err != nil {
-			return err
-		}
+	return saveDataRows(ctx, repo, merged)
+}
 
-		err = repo.AddTransactions(txCtx, txs...)
-		if err != nil {
-			return err
-		}
+// saveDataRows saves all rows of data except the block itself.
+func saveDataRows(ctx context.Context, repo repository.Repository, data blockData) error {
+	err := repo.AddCommitSignatures(ctx, data.commitSignatures...)
+	if err != nil {
+		return err
+	}
 
-		return repo.AddAccountTransactions(txCtx, accTxs...)
-	})
+	err = repo.AddEvidences(ctx, data.evidences...)
 	if err != nil {
-		return errors.New(err, "Save block info")
+		return err
 	}
 
-	i.lastBlock = processedBlock{
-		height: height,
-		txs:    txs,
+	err = repo.AddBlockEvents(ctx, data.events...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddTransactions(ctx, data.txs...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddAccountTransactions(ctx, data.accountTxs...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddIbcTransfers(ctx, data.ibcTransfers...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddMaspTxs(ctx, data.maspTxs...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddMaspAssets(ctx, data.maspAssets...)
+	if err != nil {
+		return err
+	}
+
+	err = repo.AddRawTxs(ctx, data.rawTxs...)
+	if err != nil {
+		return err
+	}
+
+	return repo.AddFailedTxs(ctx, data.failedTxs...)
+}
+
+// checkParent verifies that the block at the given height links to the block stored at height-1.
+func (i *Indexer) checkParent(ctx context.Context, height int64, lastBlockID bytes.HexBytes) error {
+	if height <= 1 {
+		return nil
+	}
+
+	parent, err := i.repository.GetBlockBy(ctx, repository.BlockFilter{Height: height - 1})
+	if err == repository.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return errors.New(err, "Get parent block")
+	}
+
+	if !gobytes.Equal(parent.BlockID, lastBlockID) {
+		logger.Error("Parent block mismatch", zap.Int64("height", height),
+			zap.String("stored_block_id", bytes.HexBytes(parent.BlockID).String()),
+			zap.String("last_block_id", lastBlockID.String()))
+		return ErrChainDiverged
 	}
 
 	return nil
 }
 
-func (i *Indexer) getCommitSignatures(blockID bytes.HexBytes, signatures []tmtypes.CommitSig) []repository.CommitSignature {
+func (i *Indexer) getCommitSignatures(blockID bytes.HexBytes, height int64, signatures []tmtypes.CommitSig) []repository.CommitSignature {
 	commitSignatures := make([]repository.CommitSignature, 0, len(signatures))
 	for _, signature := range signatures {
 		commitSignatures = append(commitSignatures, repository.CommitSignature{
 			BlockID:          blockID,
+			BlockHeight:      height,
 			BlockIDFlag:      int(signature.BlockIDFlag),
 			ValidatorAddress: signature.ValidatorAddress,
 			Timestamp:        signature.Timestamp.Unix(),
@@ -289,10 +720,20 @@ func (i *Indexer) getEvidences(blockID bytes.HexBytes, blockEvidences []tmtypes.
 	return evidences
 }
 
-func (i *Indexer) processTx(blockID bytes.HexBytes, height, txID int64, decryptedID *int, txRawData tmtypes.Tx, resultBlockResults *coretypes.ResultBlockResults) (repository.Transaction, *repository.AccountTransaction, error) {
+func (i *Indexer) processTx(blockID bytes.HexBytes, height, txID int64, decryptedID *int, txRawData tmtypes.Tx, resultBlockResults *coretypes.ResultBlockResults, prevBlock processedBlock) (repository.Transaction, txRows, error) {
 	tx, err := i.decodeTxRawData(txRawData)
 	if err != nil {
-		return repository.Transaction{}, nil, errors.New(err, "Decode tx raw data")
+		metrics.DecodeFailures.Inc()
+		return repository.Transaction{}, txRows{}, errors.New(err, "Decode tx raw data")
+	}
+
+	// The decrypted tx takes its wrapper even if it fails later, so next decrypted txs are linked to their wrappers
+	var wrapper []byte
+	if tx.Header.TxType.IsDecrypted() {
+		if prevBlock.height == height-1 && *decryptedID < len(prevBlock.wrappers) {
+			wrapper = prevBlock.wrappers[*decryptedID]
+		}
+		*decryptedID++
 	}
 
 	tx.BlockHeight = height
@@ -300,7 +741,7 @@ func (i *Indexer) processTx(blockID bytes.HexBytes, height, txID int64, decrypte
 
 	txHash, err := tx.GetHash()
 	if err != nil {
-		return repository.Transaction{}, nil, errors.New(err, "Get tx hash")
+		return repository.Transaction{}, txRows{}, errors.New(err, "Get tx hash")
 	}
 	tx.TxHash = txHash
 
@@ -308,33 +749,26 @@ func (i *Indexer) processTx(blockID bytes.HexBytes, height, txID int64, decrypte
 
 	var (
 		returnCode                    *int64
-		wrapper                       []byte
 		code                          []byte
 		feeAmountPerGasUnit, feeToken string
-		gasLimitMultiplier            *uint64
-		accountTx                     *repository.AccountTransaction
+		gasLimitMultiplier, gasUsed   *uint64
+		feePaid                       *string
+		feePayer                      string
+		rows                          txRows
+		payloadErr                    error
 	)
 	data := []byte("null")
 
 	if tx.Header.TxType.IsDecrypted() {
-
-		if i.lastBlock.height == height-1 && *decryptedID < len(i.lastBlock.txs) {
-			wrapper = i.lastBlock.txs[*decryptedID].Hash
-		}
-		*decryptedID++
-
 		codeHash, err := tx.GetCodeHash()
 		if err != nil {
-			return repository.Transaction{}, nil, errors.New(err, "Get code hash")
+			return repository.Transaction{}, txRows{}, errors.New(err, "Get code hash")
 		}
 		if !codeHash.IsEmpty() {
 			code = codeHash[:]
 		}
 
-		txType, ok := i.config.Checksums[codeHash.String()]
-		if !ok {
-			txType = "undefined"
-		}
+		txType := i.decryptedTxType(height, codeHash)
 		tx.DecryptedTxType = txType
 
 		returnCodeFound := i.findTxReturnCode(txHash, resultBlockResults)
@@ -343,38 +777,87 @@ func (i *Indexer) processTx(blockID bytes.HexBytes, height, txID int64, decrypte
 		logger.Info("Decrypted tx", zap.Int64("height", height), zap.Int64("tx_id", txID), zap.String("decrypted_tx_type", txType), zap.Int64p("return_code", returnCode))
 
 		if returnCodeFound == 0 {
-			data, accountTx, err = i.processSuccessTx(tx)
+			data, rows, err = i.processSuccessTx(tx)
 			if err != nil {
-				return repository.Transaction{}, nil, errors.New(err, "Process success tx")
+				metrics.DecodeFailures.Inc()
+				data, rows = []byte("null"), txRows{}
+				payloadErr = payloadError{errors.New(err, "Process success tx")}
 			}
 		}
 	} else if tx.Header.TxType.IsWrapper() {
 		feeAmountPerGasUnit = tx.Header.TxType.Wrapper.Fee.AmountPerGasUnit.String()
 		feeToken = tx.Header.TxType.Wrapper.Fee.Token.String()
 		gasLimitMultiplier = &tx.Header.TxType.Wrapper.GasLimit
+		fee := tx.Header.TxType.Wrapper.Fee.Total(tx.Header.TxType.Wrapper.GasLimit)
+		feePaid = &fee
+
+		// The wrapper is saved without the fee payer if its key can't be serialized
+		payer, err := tx.Header.TxType.Wrapper.Pk.ImplicitAddress()
+		if err != nil {
+			logger.Error("Get fee payer failed", zap.Int64("height", height), zap.Int64("tx_id", txID), zap.Error(err))
+		} else {
+			feePayer = payer.String()
+			rows.accountTxs = append(rows.accountTxs, repository.AccountTransaction{
+				Address:     feePayer,
+				TxHash:      txHash[:],
+				BlockHeight: height,
+				TxPos:       txID,
+				Role:        repository.RoleFeePayer,
+			})
+		}
 	}
 
+	gasUsed = i.findTxGasUsed(txHash, resultBlockResults)
+
 	memo, err := tx.GetMemo()
 	if err != nil {
-		return repository.Transaction{}, nil, err
+		return repository.Transaction{}, txRows{}, err
+	}
+
+	txTypeLabel := tx.Type()
+	if tx.DecryptedTxType != "" {
+		txTypeLabel = tx.DecryptedTxType
 	}
+	metrics.TxsProcessed.WithLabelValues(txTypeLabel).Inc()
 
 	rTx := repository.Transaction{
 		Hash:                txHash[:],
 		BlockID:             blockID,
+		BlockHeight:         height,
 		TxType:              tx.Type(),
 		WrapperID:           wrapper,
 		Memo:                memo,
 		FeeAmountPerGasUnit: feeAmountPerGasUnit,
 		FeeToken:            feeToken,
 		GasLimitMultiplier:  gasLimitMultiplier,
+		GasUsed:             gasUsed,
+		FeePaid:             feePaid,
+		FeePayer:            feePayer,
 		Code:                code,
 		Data:                data,
 		ReturnCode:          returnCode,
 		PosInBlock:          txID,
 	}
 
-	return rTx, accountTx, nil
+	return rTx, rows, payloadErr
+}
+
+// payloadError is returned by processTx with a tx which is complete except for its data, the tx can be saved
+// without data.
+type payloadError struct {
+	err error
+}
+
+func (e payloadError) Error() string {
+	return e.err.Error()
+}
+
+func (e payloadError) Unwrap() error {
+	return e.err
+}
+
+func (i *Indexer) decryptedTxType(height int64, codeHash types.Hash) string {
+	return i.config.Checksums.TxType(height, codeHash.String())
 }
 
 func (i *Indexer) decodeTxRawData(txRawData tmtypes.Tx) (types.Tx, error) {
@@ -392,6 +875,31 @@ func (i *Indexer) decodeTxRawData(txRawData tmtypes.Tx) (types.Tx, error) {
 }
 
 func (i *Indexer) findTxReturnCode(txHash types.Hash, resultBlockResults *coretypes.ResultBlockResults) int64 {
+	value, ok := i.findTxEventAttribute(txHash, resultBlockResults, "code")
+	if !ok {
+		return -1
+	}
+	v, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return -1
+	}
+	return v
+}
+
+func (i *Indexer) findTxGasUsed(txHash types.Hash, resultBlockResults *coretypes.ResultBlockResults) *uint64 {
+	value, ok := i.findTxEventAttribute(txHash, resultBlockResults, "gas_used")
+	if !ok {
+		return nil
+	}
+	v, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &v
+}
+
+// findTxEventAttribute returns value of the attribute from the end block event of the tx.
+func (i *Indexer) findTxEventAttribute(txHash types.Hash, resultBlockResults *coretypes.ResultBlockResults, key string) (string, bool) {
 	txHashS := strings.ToUpper(txHash.String())
 	for _, event := range resultBlockResults.EndBlockEvents {
 		correctEvent := false
@@ -405,59 +913,63 @@ func (i *Indexer) findTxReturnCode(txHash types.Hash, resultBlockResults *corety
 			continue
 		}
 		for _, attr := range event.Attributes {
-			if string(attr.Key) == "code" {
-				v, err := strconv.ParseInt(string(attr.Value), 10, 64)
-				if err != nil {
-					return -1
-				}
-				return v
+			if string(attr.Key) == key {
+				return string(attr.Value), true
 			}
 		}
 	}
-	return -1
+	return "", false
 }
 
-func (i *Indexer) processSuccessTx(tx types.Tx) (json.RawMessage, *repository.AccountTransaction, error) {
+func (i *Indexer) processSuccessTx(tx types.Tx) (json.RawMessage, txRows, error) {
 	dataSection, err := tx.GetSection(tx.Header.DataHash)
 	if err != nil {
-		return nil, nil, errors.New(err, "Get data section")
+		return nil, txRows{}, errors.New(err, "Get data section")
 	}
 	if dataSection == nil {
-		return nil, nil, nil
-	}
-
-	newAccountTransaction := func(secondaryAddress types.Address, defaultAddress ...*types.Address) *repository.AccountTransaction {
-		return newAccountTransactionWithTxHash(secondaryAddress, tx.TxHash, tx.BlockHeight, tx.TxPos, defaultAddress...)
+		return nil, txRows{}, nil
 	}
 
 	var data interface{}
-	var accTx *repository.AccountTransaction
+	var ibcTransfers []repository.IbcTransfer
+	accTxs := accountTxs{txHash: tx.TxHash, blockHeight: tx.BlockHeight, txPos: tx.TxPos}
+	maspRows := maspTxs{txHash: tx.TxHash, blockHeight: tx.BlockHeight, txPos: tx.TxPos}
+	maspRows.add(tx.MaspTxs()...)
+	var maspAssetData []types.AssetData
+	for _, builder := range tx.MaspBuilders() {
+		maspAssetData = append(maspAssetData, builder.AssetTypes...)
+	}
 
 	switch tx.DecryptedTxType {
 	case "tx_transfer":
 		var elem types.Transfer
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Source)
+		accTxs.add(repository.RoleSource, elem.Source)
+		accTxs.add(repository.RoleTarget, elem.Target)
 		data = elem
 	case "tx_bond":
 		var elem types.Bond
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator, elem.Source)
+		accTxs.add(repository.RoleValidator, elem.Validator)
+		accTxs.addOptional(repository.RoleSource, elem.Source)
 		data = elem
 	case "tx_unbond":
 		var elem types.Unbond
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator, elem.Source)
+		accTxs.add(repository.RoleValidator, elem.Validator)
+		accTxs.addOptional(repository.RoleSource, elem.Source)
 		data = elem
 	case "tx_bridge_pool":
 		var elem types.PendingTransfer
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Transfer.Sender)
+		accTxs.add(repository.RoleSource, elem.Transfer.Sender)
+		accTxs.add(repository.RoleFeePayer, elem.GasFee.Payer)
 		data = elem
 	case "tx_vote_proposal":
 		var elem types.VoteProposalData
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Voter)
+		accTxs.add(repository.RoleVoter, elem.Voter)
+		accTxs.add(repository.RoleValidator, elem.Delegations...)
 		data = elem
 	case "tx_reveal_pk":
 		var elem types.RevealPK
@@ -466,10 +978,15 @@ func (i *Indexer) processSuccessTx(tx types.Tx) (json.RawMessage, *repository.Ac
 	case "tx_resign_steward":
 		var elem types.ResignSteward
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
+		accTxs.add(repository.RoleSteward, types.Address(elem))
 		data = elem
 	case "tx_update_steward_commission":
 		var elem types.UpdateStewardCommission
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
+		accTxs.add(repository.RoleSteward, elem.Steward)
+		for _, item := range elem.Commission.Items {
+			accTxs.add(repository.RoleTarget, item.Key)
+		}
 		data = elem
 	case "tx_init_account":
 		var elem types.InitAccount
@@ -478,84 +995,131 @@ func (i *Indexer) processSuccessTx(tx types.Tx) (json.RawMessage, *repository.Ac
 	case "tx_update_account":
 		var elem types.UpdateAccount
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Addr)
+		accTxs.add(repository.RoleSource, elem.Addr)
 		data = elem
 	case "tx_ibc":
-		data = bytes.HexBytes(dataSection.Data.Data)
+		data, ibcTransfers = i.processIbcTx(tx, dataSection.Data.Data, &accTxs, &maspRows)
 	case "tx_become_validator":
 		var elem types.BecomeValidator
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Address)
+		accTxs.add(repository.RoleValidator, elem.Address)
 		data = elem
 	case "tx_change_consensus_key":
 		var elem types.ConsensusKeyChange
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator)
+		accTxs.add(repository.RoleValidator, elem.Validator)
 		data = elem
 	case "tx_change_validator_commission":
 		var elem types.CommissionChange
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator)
+		accTxs.add(repository.RoleValidator, elem.Validator)
 		data = elem
 	case "tx_change_validator_metadata":
 		var elem types.MetaDataChange
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator)
+		accTxs.add(repository.RoleValidator, elem.Validator)
 		data = elem
 	case "tx_claim_rewards":
 		var elem types.ClaimRewards
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator, elem.Source)
+		accTxs.add(repository.RoleValidator, elem.Validator)
+		accTxs.addOptional(repository.RoleSource, elem.Source)
 		data = elem
 	case "tx_deactivate_validator":
 		var elem types.Address
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem)
+		accTxs.add(repository.RoleValidator, elem)
 		data = elem
 	case "tx_init_proposal":
 		var elem types.InitProposalData
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Author)
+		accTxs.add(repository.RoleAuthor, elem.Author)
 		data = elem
 	case "tx_reactivate_validator":
 		var elem types.Address
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem)
+		accTxs.add(repository.RoleValidator, elem)
 		data = elem
 	case "tx_unjail_validator":
 		var elem types.Address
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem)
+		accTxs.add(repository.RoleValidator, elem)
 		data = elem
 	case "tx_redelegate":
 		var elem types.Redelegation
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Owner)
+		accTxs.add(repository.RoleSrcValidator, elem.SrcValidator)
+		accTxs.add(repository.RoleDestValidator, elem.DestValidator)
+		accTxs.add(repository.RoleSource, elem.Owner)
 		data = elem
 	case "tx_withdraw":
 		var elem types.Withdraw
 		err = borsh.Deserialize(&elem, dataSection.Data.Data)
-		accTx = newAccountTransaction(elem.Validator, elem.Source)
+		accTxs.add(repository.RoleValidator, elem.Validator)
+		accTxs.addOptional(repository.RoleSource, elem.Source)
 		data = elem
 	default:
 		data = bytes.HexBytes(dataSection.Data.Data)
 	}
 
 	if err != nil {
-		return nil, nil, err
+		return nil, txRows{}, err
 	}
 
 	jsonData, err := json.Marshal(data)
 	if err != nil {
-		return nil, nil, err
+		return nil, txRows{}, err
+	}
+	return jsonData, txRows{accountTxs: accTxs.txs, ibcTransfers: ibcTransfers, maspTxs: maspRows.txs, maspAssetData: maspAssetData}, nil
+}
+
+// txRows contains rows related to a tx which are saved along with it.
+type txRows struct {
+	accountTxs   []repository.AccountTransaction
+	ibcTransfers []repository.IbcTransfer
+	maspTxs      []repository.MaspTx
+	// maspAssetData is asset data of MaspBuilder sections, asset types are resolved per block
+	maspAssetData []types.AssetData
+}
+
+// accountTxs collects addresses involved in a tx with their roles, an address is added once per role.
+type accountTxs struct {
+	txHash      types.Hash
+	blockHeight int64
+	txPos       int64
+	txs         []repository.AccountTransaction
+}
+
+func (a *accountTxs) add(role string, addresses ...types.Address) {
+	for _, address := range addresses {
+		a.addString(role, address.String())
+	}
+}
+
+// addString adds addresses which are not decoded from Namada types, e.g. senders and receivers of IBC transfers.
+func (a *accountTxs) addString(role string, addresses ...string) {
+	for _, address := range addresses {
+		if address == "" {
+			continue
+		}
+		accTx := repository.AccountTransaction{
+			Address:     address,
+			TxHash:      a.txHash[:],
+			BlockHeight: a.blockHeight,
+			TxPos:       a.txPos,
+			Role:        role,
+		}
+		if !slices.ContainsFunc(a.txs, func(added repository.AccountTransaction) bool {
+			return added.Address == accTx.Address && added.Role == accTx.Role
+		}) {
+			a.txs = append(a.txs, accTx)
+		}
 	}
-	return jsonData, accTx, nil
 }
 
-func newAccountTransactionWithTxHash(secondaryAddress types.Address, txHash types.Hash, blockHeight, txPos int64, defaultAddress ...*types.Address) *repository.AccountTransaction {
-	address := secondaryAddress
-	if len(defaultAddress) != 0 && defaultAddress[0] != nil {
-		address = *defaultAddress[0]
+// addOptional adds the address if it's set, e.g. source of a bond is empty for self-bonds.
+func (a *accountTxs) addOptional(role string, address *types.Address) {
+	if address != nil {
+		a.add(role, *address)
 	}
-	return &repository.AccountTransaction{Address: address.String(), TxHash: txHash[:], BlockHeight: blockHeight, TxPos: txPos}
 }
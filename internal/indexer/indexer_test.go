@@ -0,0 +1,824 @@
+package indexer
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/bytes"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/the-laziest/namadexer-go/internal/checksums"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/repository/memory"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/internal/types/masp"
+	ptypes "github.com/the-laziest/namadexer-go/internal/types/proto"
+	"github.com/the-laziest/namadexer-go/pkg/borsh"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var genesis = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func testBlockID(fork string, height int64) bytes.HexBytes {
+	sum := sha256.Sum256([]byte(fork + "/" + strconv.FormatInt(height, 10)))
+	return sum[:]
+}
+
+// testChain returns blocks 1..height linked by parent ids. Blocks from forkHeight on belong to the fork,
+// so they differ from blocks of the same heights of the main chain and other forks.
+func testChain(height int64, fork string, forkHeight int64) map[int64]blockInfo {
+	forkOf := func(height int64) string {
+		if fork != "" && height >= forkHeight {
+			return fork
+		}
+		return "main"
+	}
+
+	blocks := make(map[int64]blockInfo, height)
+	for h := int64(1); h <= height; h++ {
+		header := tmtypes.Header{
+			ChainID: "test-chain",
+			Height:  h,
+			Time:    genesis.Add(time.Duration(h) * time.Second),
+		}
+		if h > 1 {
+			header.LastBlockID = tmtypes.BlockID{Hash: testBlockID(forkOf(h-1), h-1)}
+		}
+		blocks[h] = blockInfo{
+			resultBlock: &coretypes.ResultBlock{
+				BlockID: tmtypes.BlockID{Hash: testBlockID(forkOf(h), h)},
+				Block: &tmtypes.Block{
+					Header:     header,
+					LastCommit: &tmtypes.Commit{Height: h - 1},
+				},
+			},
+			resultBlockResults: &coretypes.ResultBlockResults{Height: h},
+		}
+	}
+	return blocks
+}
+
+// encodeTx serializes the tx in the format of block txs.
+func encodeTx(t *testing.T, tx types.Tx) tmtypes.Tx {
+	t.Helper()
+	data, err := borsh.Serialize(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bs, err := proto.Marshal(&ptypes.Tx{Data: data})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return bs
+}
+
+func wrapperTx(t *testing.T, timestamp string) tmtypes.Tx {
+	return encodeTx(t, types.Tx{Header: types.Header{
+		ChainID:   "test-chain",
+		Timestamp: timestamp,
+		TxType:    types.TxType{Enum: 1, Wrapper: types.WrapperTx{GasLimit: 10}},
+	}})
+}
+
+func decryptedTx(t *testing.T, timestamp string) tmtypes.Tx {
+	return encodeTx(t, types.Tx{Header: types.Header{
+		ChainID:   "test-chain",
+		Timestamp: timestamp,
+		TxType:    types.TxType{Enum: 2},
+	}})
+}
+
+// fakeSource serves fixed blocks. Requests of gated heights block until the gate is closed.
+type fakeSource struct {
+	blocks map[int64]blockInfo
+	gates  map[int64]chan struct{}
+
+	maxRequested atomic.Int64
+}
+
+func (s *fakeSource) wait(ctx context.Context, height int64) error {
+	for {
+		requested := s.maxRequested.Load()
+		if height <= requested || s.maxRequested.CompareAndSwap(requested, height) {
+			break
+		}
+	}
+	if gate, ok := s.gates[height]; ok {
+		select {
+		case <-gate:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+func (s *fakeSource) Block(ctx context.Context, height int64) (*coretypes.ResultBlock, error) {
+	if err := s.wait(ctx, height); err != nil {
+		return nil, err
+	}
+	info, ok := s.blocks[height]
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+	return info.resultBlock, nil
+}
+
+func (s *fakeSource) BlockResults(_ context.Context, height int64) (*coretypes.ResultBlockResults, error) {
+	info, ok := s.blocks[height]
+	if !ok {
+		return nil, ErrBlockNotFound
+	}
+	return info.resultBlockResults, nil
+}
+
+func (s *fakeSource) LatestHeight(_ context.Context) (int64, error) {
+	latest := int64(0)
+	for height := range s.blocks {
+		latest = max(latest, height)
+	}
+	return latest, nil
+}
+
+// WaitForHeight never waits since blocks don't appear later.
+func (s *fakeSource) WaitForHeight(_ context.Context, _ int64, _ int) error {
+	return ErrSourceExhausted
+}
+
+func (s *fakeSource) Close() error {
+	return nil
+}
+
+func testChecksums(t *testing.T) *checksums.Registry {
+	t.Helper()
+	file := filepath.Join(t.TempDir(), "checksums.json")
+	if err := os.WriteFile(file, []byte(`{"tx_transfer.wasm": "tx_transfer.0000000000000000000000000000000000000000000000000000000000000001.wasm"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	registry, err := checksums.New(checksums.Config{Versions: []checksums.Version{{Files: []string{file}}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return registry
+}
+
+func testIndexer(t *testing.T, source BlockSource, repo repository.Repository, policy string) *Indexer {
+	return NewWithSource(Config{
+		Checksums:           testChecksums(t),
+		WaitForBlock:        1,
+		FetchWorkers:        4,
+		FetchWindow:         4,
+		DecodeFailurePolicy: policy,
+	}, source, repo)
+}
+
+// runIndexer indexes all blocks of the source and stops the indexer.
+func runIndexer(t *testing.T, indexer *Indexer) error {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	err := indexer.Start(ctx)
+	cancel()
+	indexer.Close()
+	return err
+}
+
+// checkStoredChain checks that the stored blocks are exactly the blocks of the chain.
+func checkStoredChain(t *testing.T, repo repository.Repository, chain map[int64]blockInfo) {
+	t.Helper()
+	ctx := context.Background()
+
+	lastHeight, err := repo.GetLastHeight(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantHeight := int64(0)
+	for height := range chain {
+		wantHeight = max(wantHeight, height)
+	}
+	if lastHeight != wantHeight {
+		t.Fatalf("last height %d, want %d", lastHeight, wantHeight)
+	}
+	for height, info := range chain {
+		block, err := repo.GetBlockBy(ctx, repository.BlockFilter{Height: height})
+		if err != nil {
+			t.Fatalf("height %d: %v", height, err)
+		}
+		if !slices.Equal(block.BlockID, info.resultBlock.BlockID.Hash) {
+			t.Fatalf("height %d: stored block %X, want %X", height, block.BlockID, info.resultBlock.BlockID.Hash)
+		}
+	}
+}
+
+// collectBlocks runs blockFetcher and returns heights in the order they are pushed to the block channel.
+// check is called with every received height.
+func collectBlocks(ctx context.Context, indexer *Indexer, startHeight, endHeight int64, check func(height int64)) []int64 {
+	blockChan := make(chan blockInfo)
+	go func() {
+		defer close(blockChan)
+		indexer.blockFetcher(ctx, startHeight, endHeight, blockChan)
+	}()
+
+	var heights []int64
+	for info := range blockChan {
+		heights = append(heights, info.resultBlock.Block.Height)
+		if check != nil {
+			check(info.resultBlock.Block.Height)
+		}
+	}
+	return heights
+}
+
+func TestBlockFetcherOrder(t *testing.T) {
+	tests := []struct {
+		name    string
+		workers int
+		window  int
+		release []int64
+	}{
+		{"in order", 4, 4, []int64{1, 2, 3, 4, 5, 6, 7, 8}},
+		{"reverse", 4, 4, []int64{8, 7, 6, 5, 4, 3, 2, 1}},
+		{"interleaved", 3, 5, []int64{2, 4, 1, 6, 3, 8, 5, 7}},
+		{"window wider than workers", 2, 8, []int64{5, 3, 8, 1, 7, 2, 6, 4}},
+		{"single worker", 1, 1, []int64{3, 2, 1, 4, 6, 5, 8, 7}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &fakeSource{blocks: testChain(8, "", 0), gates: make(map[int64]chan struct{})}
+			for height := range source.blocks {
+				source.gates[height] = make(chan struct{})
+			}
+			indexer := NewWithSource(Config{FetchWorkers: tt.workers, FetchWindow: tt.window}, source, nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			go func() {
+				for _, height := range tt.release {
+					close(source.gates[height])
+					time.Sleep(time.Millisecond)
+				}
+			}()
+
+			heights := collectBlocks(ctx, indexer, 1, 8, func(height int64) {
+				// The next height is requested only after the previous one is pushed, so at most window heights
+				// after the received one can be requested
+				if requested := source.maxRequested.Load(); requested > height+int64(tt.window) {
+					t.Errorf("height %d requested while waiting for height %d with window %d", requested, height, tt.window)
+				}
+			})
+
+			if want := []int64{1, 2, 3, 4, 5, 6, 7, 8}; !slices.Equal(heights, want) {
+				t.Fatalf("heights %v, want %v", heights, want)
+			}
+		})
+	}
+}
+
+func TestBlockFetcherCancel(t *testing.T) {
+	tests := []struct {
+		name      string
+		endHeight int64
+		// released heights are served, requests of other heights block until cancellation
+		released int64
+	}{
+		{"nothing received", 0, 0},
+		{"partially received", 0, 3},
+		{"bounded range", 6, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			source := &fakeSource{blocks: testChain(10, "", 0), gates: make(map[int64]chan struct{})}
+			for height := range source.blocks {
+				if height > tt.released {
+					source.gates[height] = make(chan struct{})
+				}
+			}
+			indexer := NewWithSource(Config{FetchWorkers: 3, FetchWindow: 4}, source, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			done := make(chan []int64)
+			go func() {
+				done <- collectBlocks(ctx, indexer, 1, tt.endHeight, func(height int64) {
+					if height == tt.released {
+						cancel()
+					}
+				})
+			}()
+			if tt.released == 0 {
+				cancel()
+			}
+
+			select {
+			case heights := <-done:
+				if len(heights) != int(tt.released) {
+					t.Fatalf("received heights %v, want 1..%d", heights, tt.released)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("block fetcher didn't stop after cancellation")
+			}
+		})
+	}
+}
+
+func TestPrepareBlockChainDiverged(t *testing.T) {
+	chain := testChain(5, "", 0)
+	fork := testChain(5, "fork", 2)
+
+	tests := []struct {
+		name    string
+		stored  int64
+		pending []blockInfo
+		block   blockInfo
+		wantErr error
+	}{
+		{"links to stored parent", 3, nil, chain[4], nil},
+		{"stored parent differs", 3, nil, fork[4], ErrChainDiverged},
+		{"parent isn't stored", 1, nil, fork[4], nil},
+		{"links to pending parent", 2, []blockInfo{chain[3], chain[4]}, chain[5], nil},
+		{"pending parent differs", 1, []blockInfo{chain[2]}, fork[3], ErrChainDiverged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewRepository()
+			for height := int64(1); height <= tt.stored; height++ {
+				if err := repo.AddBlock(ctx, repository.Block{BlockID: chain[height].resultBlock.BlockID.Hash, HeaderHeight: height}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			indexer := testIndexer(t, &fakeSource{}, repo, DecodeFailureHalt)
+
+			var (
+				pending []blockData
+				err     error
+			)
+			for _, info := range append(tt.pending, tt.block) {
+				var data blockData
+				if data, err = indexer.prepareBlock(ctx, info, pending); err != nil {
+					break
+				}
+				pending = append(pending, data)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRollback(t *testing.T) {
+	tests := []struct {
+		name       string
+		forkHeight int64
+		// height of the fork is above stored blocks, the fork is detected by the parent of the first new block
+		height   int64
+		maxDepth int64
+		// blocks up to prunedHeight are pruned before the fork is indexed
+		prunedHeight int64
+		wantErr      bool
+	}{
+		{"tip replaced", 5, 6, 0, 0, false},
+		{"tip replaced by longer fork", 5, 8, 0, 0, false},
+		{"deep fork", 3, 6, 0, 0, false},
+		{"fork right after the first block", 2, 6, 0, 0, false},
+		{"fork within max depth", 4, 6, 2, 0, false},
+		{"fork deeper than max depth", 3, 6, 2, 0, true},
+		{"fork at the first block", 1, 6, 0, 0, true},
+		{"fork right after the earliest stored block", 4, 6, 0, 2, false},
+		{"fork at the earliest stored block", 3, 6, 0, 2, true},
+		{"fork below the earliest stored block", 2, 6, 0, 2, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewRepository()
+
+			chain := testChain(5, "", 0)
+			if err := runIndexer(t, testIndexer(t, &fakeSource{blocks: chain}, repo, DecodeFailureHalt)); err != nil {
+				t.Fatal(err)
+			}
+			if tt.prunedHeight > 0 {
+				if err := repo.PruneBlocks(ctx, 1, tt.prunedHeight, ""); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			fork := testChain(tt.height, "fork", tt.forkHeight)
+			indexer := testIndexer(t, &fakeSource{blocks: fork}, repo, DecodeFailureHalt)
+			indexer.config.MaxRollbackDepth = tt.maxDepth
+			err := runIndexer(t, indexer)
+			if tt.wantErr {
+				if !errors.Is(err, ErrForkNotFound) {
+					t.Fatalf("error %v, want %v", err, ErrForkNotFound)
+				}
+				// Nothing is deleted if the fork isn't found
+				fork = chain
+			} else if err != nil {
+				t.Fatal(err)
+			}
+
+			for height := int64(1); height <= tt.prunedHeight; height++ {
+				delete(fork, height)
+			}
+			checkStoredChain(t, repo, fork)
+		})
+	}
+}
+
+func TestReindexChunks(t *testing.T) {
+	tests := []struct {
+		name       string
+		fromHeight int64
+		toHeight   int64
+		// blocks from forkHeight on are served from a fork, 0 serves the stored chain
+		forkHeight int64
+		wantErr    error
+	}{
+		{"several chunks", 2, 7, 0, nil},
+		{"single partial chunk", 3, 4, 0, nil},
+		{"chunk not linked to the next stored block", 2, 5, 5, ErrChainDiverged},
+		{"chunk not linked to the previous stored block", 4, 7, 3, ErrChainDiverged},
+		{"range above stored blocks", 5, 8, 0, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewRepository()
+
+			chain := testChain(7, "", 0)
+			if err := runIndexer(t, testIndexer(t, &fakeSource{blocks: chain}, repo, DecodeFailureHalt)); err != nil {
+				t.Fatal(err)
+			}
+
+			source := &fakeSource{blocks: chain}
+			if tt.forkHeight > 0 {
+				source = &fakeSource{blocks: testChain(7, "fork", tt.forkHeight)}
+			}
+			indexer := testIndexer(t, source, repo, DecodeFailureHalt)
+			indexer.config.BulkBatchSize = 3
+			err := indexer.Reindex(ctx, tt.fromHeight, tt.toHeight)
+			indexer.Close()
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error %v, want %v", err, tt.wantErr)
+			}
+
+			// Chunks replaced before the failure are the same blocks, so the stored chain is unchanged
+			checkStoredChain(t, repo, chain)
+		})
+	}
+}
+
+func TestDecodeFailurePolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     string
+		wantErr    bool
+		wantHeight int64
+		wantFailed []int64
+	}{
+		{"halt", DecodeFailureHalt, true, 1, nil},
+		{"quarantine", DecodeFailureQuarantine, false, 3, []int64{2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewRepository()
+
+			chain := testChain(3, "", 0)
+			chain[2].resultBlock.Block.Data.Txs = tmtypes.Txs{wrapperTx(t, "1"), []byte("not a tx"), wrapperTx(t, "2")}
+
+			err := runIndexer(t, testIndexer(t, &fakeSource{blocks: chain}, repo, tt.policy))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+
+			lastHeight, err := repo.GetLastHeight(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if lastHeight != tt.wantHeight {
+				t.Fatalf("last height %d, want %d", lastHeight, tt.wantHeight)
+			}
+
+			failed, err := repo.GetFailedTxHeights(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(failed, tt.wantFailed) {
+				t.Fatalf("failed tx heights %v, want %v", failed, tt.wantFailed)
+			}
+			if len(tt.wantFailed) == 0 {
+				return
+			}
+
+			txs, err := repo.GetTotalTxsBy(ctx, repository.TxFilter{BlockID: chain[2].resultBlock.BlockID.Hash})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if txs != 2 {
+				t.Fatalf("stored %d txs, want 2", txs)
+			}
+		})
+	}
+}
+
+// transferTx returns a decrypted tx_transfer tx with the payload as its data.
+func transferTx(t *testing.T, timestamp string, payload []byte) tmtypes.Tx {
+	t.Helper()
+	var codeHash types.Hash
+	codeHash[len(codeHash)-1] = 1
+	code := types.Section{Enum: 2, Code: types.SectionCode{Code: types.Commitment{Hash: codeHash}}}
+	data := types.Section{Enum: 0, Data: types.SectionData{Data: payload}}
+
+	tx := types.Tx{
+		Header: types.Header{
+			ChainID:   "test-chain",
+			Timestamp: timestamp,
+			TxType:    types.TxType{Enum: 2},
+		},
+		Sections: []types.Section{code, data},
+	}
+	var err error
+	if tx.Header.CodeHash, err = code.GetHash(); err != nil {
+		t.Fatal(err)
+	}
+	if tx.Header.DataHash, err = data.GetHash(); err != nil {
+		t.Fatal(err)
+	}
+	return encodeTx(t, tx)
+}
+
+// testTxHash returns the hash of the encoded tx.
+func testTxHash(t *testing.T, raw tmtypes.Tx) []byte {
+	t.Helper()
+	tx, err := (&Indexer{}).decodeTxRawData(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	hash, err := tx.GetHash()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return hash[:]
+}
+
+// appliedEvent returns the end block event with the result of the tx.
+func appliedEvent(t *testing.T, raw tmtypes.Tx, code string) abci.Event {
+	return abci.Event{Type: "applied", Attributes: []abci.EventAttribute{
+		{Key: "hash", Value: strings.ToUpper(hex.EncodeToString(testTxHash(t, raw)))},
+		{Key: "code", Value: code},
+	}}
+}
+
+// blockTxs returns stored txs of the block at the height with the type ordered by position.
+func blockTxs(t *testing.T, repo repository.Repository, height int64, txType string) []repository.Transaction {
+	t.Helper()
+	txs, err := getBlockTxs(context.Background(), repo, height, txType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return txs
+}
+
+func TestQuarantineWrapperIDs(t *testing.T) {
+	wrappers := tmtypes.Txs{wrapperTx(t, "w1"), wrapperTx(t, "w2"), wrapperTx(t, "w3")}
+	decrypted := tmtypes.Txs{decryptedTx(t, "d1"), decryptedTx(t, "d2"), decryptedTx(t, "d3")}
+	garbage := tmtypes.Tx("not a tx")
+
+	tests := []struct {
+		name string
+		// txs of blocks 2 and 3, decrypted txs of block 3 belong to wrappers of block 2
+		block2, block3 tmtypes.Txs
+		// blocks up to restartHeight are indexed before the indexer is restarted
+		restartHeight int64
+		wantWrappers  [][]byte
+		wantFailed    []repository.FailedTx
+	}{
+		{
+			"wrapper can't be decoded",
+			tmtypes.Txs{wrappers[0], garbage, wrappers[2]}, decrypted, 0,
+			[][]byte{testTxHash(t, wrappers[0]), nil, testTxHash(t, wrappers[2])},
+			[]repository.FailedTx{{BlockHeight: 2, TxPos: 1, TxType: "Wrapper"}},
+		},
+		{
+			"wrapper can't be decoded before restart",
+			tmtypes.Txs{wrappers[0], garbage, wrappers[2]}, decrypted, 2,
+			[][]byte{testTxHash(t, wrappers[0]), nil, testTxHash(t, wrappers[2])},
+			[]repository.FailedTx{{BlockHeight: 2, TxPos: 1, TxType: "Wrapper"}},
+		},
+		{
+			"decrypted tx can't be decoded",
+			wrappers, tmtypes.Txs{decrypted[0], garbage, decrypted[2], wrapperTx(t, "w4")}, 0,
+			[][]byte{testTxHash(t, wrappers[0]), testTxHash(t, wrappers[2])},
+			[]repository.FailedTx{{BlockHeight: 3, TxPos: 1, TxType: "Decrypted"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			repo := memory.NewRepository()
+
+			chain := testChain(3, "", 0)
+			chain[2].resultBlock.Block.Data.Txs = tt.block2
+			chain[3].resultBlock.Block.Data.Txs = tt.block3
+
+			if tt.restartHeight > 0 {
+				restart := map[int64]blockInfo{}
+				for height := int64(1); height <= tt.restartHeight; height++ {
+					restart[height] = chain[height]
+				}
+				if err := runIndexer(t, testIndexer(t, &fakeSource{blocks: restart}, repo, DecodeFailureQuarantine)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := runIndexer(t, testIndexer(t, &fakeSource{blocks: chain}, repo, DecodeFailureQuarantine)); err != nil {
+				t.Fatal(err)
+			}
+
+			var gotWrappers [][]byte
+			for _, tx := range blockTxs(t, repo, 3, "Decrypted") {
+				gotWrappers = append(gotWrappers, tx.WrapperID)
+			}
+			if !slices.EqualFunc(gotWrappers, tt.wantWrappers, slices.Equal) {
+				t.Fatalf("wrapper ids %X, want %X", gotWrappers, tt.wantWrappers)
+			}
+
+			failed, err := repo.GetFailedTxs(ctx, 0, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(failed) != len(tt.wantFailed) {
+				t.Fatalf("failed txs %+v, want %+v", failed, tt.wantFailed)
+			}
+			for n, want := range tt.wantFailed {
+				if failed[n].BlockHeight != want.BlockHeight || failed[n].TxPos != want.TxPos || failed[n].TxType != want.TxType {
+					t.Fatalf("failed tx %d:%d %s, want %d:%d %s", failed[n].BlockHeight, failed[n].TxPos, failed[n].TxType,
+						want.BlockHeight, want.TxPos, want.TxType)
+				}
+			}
+		})
+	}
+}
+
+func TestRetryFailedTxs(t *testing.T) {
+	ctx := context.Background()
+	repo := memory.NewRepository()
+
+	payload, err := borsh.Serialize(types.Transfer{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	badTransfer := transferTx(t, "d1", []byte("not a transfer"))
+	transfer := transferTx(t, "d1", payload)
+	wrappers := tmtypes.Txs{wrapperTx(t, "w1"), wrapperTx(t, "w2")}
+
+	chain := testChain(3, "", 0)
+	chain[2].resultBlock.Block.Data.Txs = tmtypes.Txs{wrappers[0], []byte("not a tx")}
+	chain[3].resultBlock.Block.Data.Txs = tmtypes.Txs{badTransfer, decryptedTx(t, "d2")}
+	chain[3].resultBlockResults.EndBlockEvents = []abci.Event{appliedEvent(t, badTransfer, "0")}
+
+	if err = runIndexer(t, testIndexer(t, &fakeSource{blocks: chain}, repo, DecodeFailureQuarantine)); err != nil {
+		t.Fatal(err)
+	}
+
+	// The transfer is saved without data, only its payload is quarantined
+	decrypted := blockTxs(t, repo, 3, "Decrypted")
+	if len(decrypted) != 2 || string(decrypted[0].Data) != "null" || decrypted[1].WrapperID != nil {
+		t.Fatalf("decrypted txs %+v, want transfer without data and tx without wrapper", decrypted)
+	}
+
+	// Failed txs are retried from raw data, replaced data simulates fixed decoding
+	for _, retry := range []struct {
+		height, txPos int64
+		raw           tmtypes.Tx
+	}{{2, 1, wrappers[1]}, {3, 0, transfer}} {
+		failed, err := repo.GetBlockFailedTxs(ctx, retry.height)
+		if err != nil || len(failed) != 1 {
+			t.Fatalf("height %d: failed txs %+v, %v", retry.height, failed, err)
+		}
+		if err = repo.DeleteFailedTx(ctx, retry.height, retry.txPos); err != nil {
+			t.Fatal(err)
+		}
+		failed[0].Data = retry.raw
+		if err = repo.AddFailedTxs(ctx, failed[0]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	indexer := testIndexer(t, &fakeSource{}, repo, DecodeFailureQuarantine)
+	err = indexer.RetryFailedTxs(ctx)
+	indexer.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	heights, err := repo.GetFailedTxHeights(ctx)
+	if err != nil || len(heights) != 0 {
+		t.Fatalf("failed tx heights %v, %v, want none", heights, err)
+	}
+	if stored := blockTxs(t, repo, 2, "Wrapper"); len(stored) != 2 || !slices.Equal(stored[1].Hash, testTxHash(t, wrappers[1])) {
+		t.Fatalf("wrappers %+v, want retried wrapper at 1", stored)
+	}
+
+	decrypted = blockTxs(t, repo, 3, "Decrypted")
+	if string(decrypted[0].Data) == "null" {
+		t.Fatal("transfer data isn't updated")
+	}
+	if !slices.Equal(decrypted[1].WrapperID, testTxHash(t, wrappers[1])) {
+		t.Fatalf("wrapper id %X, want retried wrapper %X", decrypted[1].WrapperID, testTxHash(t, wrappers[1]))
+	}
+}
+
+func TestMaspAssetRegistry(t *testing.T) {
+	ctx := context.Background()
+	token, err := types.ParseAddress("tnam1q9gr66cvu4hrzm0sd5kmlnjje82gs3xlfg3v6nu7")
+	if err != nil {
+		t.Fatalf("ParseAddress: %v", err)
+	}
+	assetData := func(epoch uint64) types.AssetData {
+		return types.AssetData{Token: token, Denom: 6, Position: types.MaspDigitPosOne, Epoch: &epoch}
+	}
+	maspTx := func(epochs ...uint64) []repository.MaspTx {
+		var values []repository.MaspAssetValue
+		for _, epoch := range epochs {
+			assetType := mustAssetType(t, assetData(epoch))
+			values = append(values, repository.MaspAssetValue{AssetType: hex.EncodeToString(assetType[:])})
+		}
+		return []repository.MaspTx{{ValueBalance: values}}
+	}
+	resolvedEpochs := func(registry *maspAssetRegistry, repo repository.Repository, data []types.AssetData, epochs ...uint64) []int64 {
+		assets, err := registry.resolve(ctx, repo, data, maspTx(epochs...))
+		if err != nil {
+			t.Fatalf("resolve: %v", err)
+		}
+		var result []int64
+		for _, asset := range assets {
+			result = append(result, *asset.Epoch)
+		}
+		slices.Sort(result)
+		return result
+	}
+
+	repo := memory.NewRepository()
+	var registry maspAssetRegistry
+
+	// Only asset types of the latest maspCandidateEpochs epochs are computed
+	latest := uint64(100)
+	got := resolvedEpochs(&registry, repo, []types.AssetData{assetData(latest)}, latest-maspCandidateEpochs, latest-maspCandidateEpochs+1, latest-1)
+	want := []int64{int64(latest - maspCandidateEpochs + 1), int64(latest - 1), int64(latest)}
+	if !slices.Equal(got, want) {
+		t.Fatalf("resolved epochs %v, want %v", got, want)
+	}
+	if len(registry.candidates) > 4*(maspCandidateEpochs+1) {
+		t.Fatalf("%d candidates computed, want at most %d", len(registry.candidates), 4*(maspCandidateEpochs+1))
+	}
+
+	// Candidates of epochs out of the window are dropped when the latest epoch grows
+	latest += maspCandidateEpochs
+	resolvedEpochs(&registry, repo, []types.AssetData{assetData(latest)}, latest-1)
+	if len(registry.candidates) > 4*(maspCandidateEpochs+1) {
+		t.Fatalf("%d candidates kept, want at most %d", len(registry.candidates), 4*(maspCandidateEpochs+1))
+	}
+
+	// After reset the registry is loaded from the database, so the unsaved latest epoch is dropped
+	saved := uint64(100)
+	if err := repo.AddMaspAssets(ctx, maspAsset(mustAssetType(t, assetData(saved)), assetData(saved))); err != nil {
+		t.Fatalf("AddMaspAssets: %v", err)
+	}
+	registry.reset()
+	got = resolvedEpochs(&registry, repo, nil, saved-1, latest-1)
+	if want := []int64{int64(saved - 1)}; !slices.Equal(got, want) {
+		t.Fatalf("resolved epochs after reset %v, want %v", got, want)
+	}
+}
+
+func mustAssetType(t *testing.T, data types.AssetData) masp.AssetType {
+	t.Helper()
+	assetType, err := data.AssetType()
+	if err != nil {
+		t.Fatalf("AssetType: %v", err)
+	}
+	return assetType
+}
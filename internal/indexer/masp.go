@@ -0,0 +1,56 @@
+package indexer
+
+import (
+	"encoding/hex"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/internal/types/masp"
+)
+
+// maspTxs collects MASP transactions of a tx in order of appearance: sections first, then the IBC shielded transfer.
+type maspTxs struct {
+	txHash      types.Hash
+	blockHeight int64
+	txPos       int64
+	txs         []repository.MaspTx
+}
+
+func (m *maspTxs) add(txs ...masp.Transaction) {
+	for _, tx := range txs {
+		data := tx.Data
+		m.txs = append(m.txs, repository.MaspTx{
+			TxHash:             m.txHash[:],
+			BlockHeight:        m.blockHeight,
+			TxPos:              m.txPos,
+			MaspPos:            int64(len(m.txs)),
+			ExpiryHeight:       int64(data.ExpiryHeight),
+			SpendsCount:        int64(len(data.SaplingBundle.Spends)),
+			ConvertsCount:      int64(len(data.SaplingBundle.Converts)),
+			OutputsCount:       int64(len(data.SaplingBundle.Outputs)),
+			ValueBalance:       maspAssetValues(data.SaplingBundle.ValueBalance),
+			TransparentInputs:  maspTransparentValues(data.TransparentBundle.Vin),
+			TransparentOutputs: maspTransparentValues(data.TransparentBundle.Vout),
+		})
+	}
+}
+
+func maspAssetValues(values []masp.AssetValue) []repository.MaspAssetValue {
+	result := make([]repository.MaspAssetValue, 0, len(values))
+	for _, v := range values {
+		result = append(result, repository.MaspAssetValue{AssetType: hex.EncodeToString(v.AssetType[:]), Value: v.Value.String()})
+	}
+	return result
+}
+
+func maspTransparentValues(values masp.TxsInOut) []repository.MaspTransparentValue {
+	result := make([]repository.MaspTransparentValue, 0, len(values))
+	for _, v := range values {
+		result = append(result, repository.MaspTransparentValue{
+			AssetType: hex.EncodeToString(v.AssetType[:]),
+			Value:     v.Value,
+			Address:   hex.EncodeToString(v.Address[:]),
+		})
+	}
+	return result
+}
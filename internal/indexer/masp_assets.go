@@ -0,0 +1,251 @@
+package indexer
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/internal/types/masp"
+	"github.com/the-laziest/namadexer-go/pkg/borsh"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// maspAssetRegistry maps MASP asset types to their asset data. Asset data of MaspBuilder sections is registered
+// with asset types computed from it. Other asset types of MASP txs are resolved by computing asset types of registered
+// tokens for every digit position and the latest maspCandidateEpochs epochs up to the latest registered one.
+type maspAssetRegistry struct {
+	mu     sync.Mutex
+	loaded bool
+
+	assets map[masp.AssetType]repository.MaspAsset
+	// hashes caches asset types of serialized asset data
+	hashes   map[string]masp.AssetType
+	tokens   map[maspToken]types.Address
+	maxEpoch *uint64
+
+	// candidates are asset types of registered tokens, candidateEpochs is the number of epochs computed per token
+	candidates      map[masp.AssetType]repository.MaspAsset
+	candidateEpochs map[maspToken]uint64
+}
+
+// maspCandidateEpochs bounds the number of epochs asset types of every token are computed for, so computing them
+// on the first unknown asset type takes bounded time and memory. Asset types of older epochs are resolved only
+// if their asset data is registered by MaspBuilder sections.
+const maspCandidateEpochs = 64
+
+type maspToken struct {
+	token string
+	denom uint8
+}
+
+func maspAsset(assetType masp.AssetType, data types.AssetData) repository.MaspAsset {
+	asset := repository.MaspAsset{
+		AssetType: assetType[:],
+		Token:     data.Token.String(),
+		Denom:     int64(data.Denom),
+		Position:  int64(data.Position),
+	}
+	if data.Epoch != nil {
+		epoch := int64(*data.Epoch)
+		asset.Epoch = &epoch
+	}
+	return asset
+}
+
+func (r *maspAssetRegistry) load(ctx context.Context, repo repository.Repository) error {
+	if r.loaded {
+		return nil
+	}
+
+	r.assets = make(map[masp.AssetType]repository.MaspAsset)
+	r.hashes = make(map[string]masp.AssetType)
+	r.tokens = make(map[maspToken]types.Address)
+	r.candidates = make(map[masp.AssetType]repository.MaspAsset)
+	r.candidateEpochs = make(map[maspToken]uint64)
+	r.maxEpoch = nil
+
+	assets, err := repo.GetMaspAssets(ctx)
+	if err != nil {
+		return errors.New(err, "Get MASP assets")
+	}
+	for _, asset := range assets {
+		var assetType masp.AssetType
+		copy(assetType[:], asset.AssetType)
+		r.assets[assetType] = asset
+
+		token, err := types.ParseAddress(asset.Token)
+		if err != nil {
+			logger.Warn("Parse MASP asset token failed", zap.String("token", asset.Token), zap.Error(err))
+			continue
+		}
+		r.addToken(token, uint8(asset.Denom), asset.Epoch)
+	}
+
+	r.loaded = true
+	return nil
+}
+
+// reset drops the registry, so it's loaded from the database again on next resolve. Tokens and epochs
+// registered from blocks which were deleted or failed to save don't stay in the registry.
+func (r *maspAssetRegistry) reset() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.loaded = false
+}
+
+func (r *maspAssetRegistry) addToken(token types.Address, denom uint8, epoch *int64) {
+	r.tokens[maspToken{token.String(), denom}] = token
+	if epoch != nil && (r.maxEpoch == nil || uint64(*epoch) > *r.maxEpoch) {
+		maxEpoch := uint64(*epoch)
+		r.maxEpoch = &maxEpoch
+	}
+}
+
+func (r *maspAssetRegistry) assetType(data types.AssetData) (masp.AssetType, error) {
+	bs, err := borsh.Serialize(data)
+	if err != nil {
+		return masp.AssetType{}, errors.New(err, "Serialize asset data")
+	}
+	if assetType, ok := r.hashes[string(bs)]; ok {
+		return assetType, nil
+	}
+	assetType, err := data.AssetType()
+	if err != nil {
+		return masp.AssetType{}, err
+	}
+	r.hashes[string(bs)] = assetType
+	return assetType, nil
+}
+
+// extendCandidates computes asset types of registered tokens which are not computed yet and drops
+// asset types of epochs which are out of the latest maspCandidateEpochs epochs.
+func (r *maspAssetRegistry) extendCandidates() error {
+	epochs := uint64(0)
+	if r.maxEpoch != nil {
+		epochs = *r.maxEpoch + 1
+	}
+	fromEpoch := uint64(0)
+	if epochs > maspCandidateEpochs {
+		fromEpoch = epochs - maspCandidateEpochs
+	}
+
+	tokens := 0
+	for key, token := range r.tokens {
+		computed, ok := r.candidateEpochs[key]
+		if ok && computed == epochs {
+			continue
+		}
+		tokens++
+
+		add := func(epoch *uint64) error {
+			for position := types.MaspDigitPosZero; position <= types.MaspDigitPosThree; position++ {
+				data := types.AssetData{Token: token, Denom: key.denom, Position: position, Epoch: epoch}
+				assetType, err := data.AssetType()
+				if err != nil {
+					return err
+				}
+				r.candidates[assetType] = maspAsset(assetType, data)
+			}
+			return nil
+		}
+		// Asset types without epoch are computed along with the first epochs
+		if !ok {
+			if err := add(nil); err != nil {
+				return err
+			}
+		}
+		for epoch := max(computed, fromEpoch); epoch < epochs; epoch++ {
+			if err := add(&epoch); err != nil {
+				return err
+			}
+		}
+		r.candidateEpochs[key] = epochs
+	}
+
+	if tokens > 0 {
+		for assetType, asset := range r.candidates {
+			if asset.Epoch != nil && uint64(*asset.Epoch) < fromEpoch {
+				delete(r.candidates, assetType)
+			}
+		}
+		logger.Info("MASP asset types of tokens computed", zap.Int("tokens", tokens), zap.Uint64("epochs", epochs))
+	}
+	return nil
+}
+
+// resolve registers asset data and returns assets of the asset data and of asset types used by MASP txs
+// which can be resolved. Assets are returned even if they are saved already, so they can be saved again
+// if saving of previous blocks is rolled back.
+func (r *maspAssetRegistry) resolve(ctx context.Context, repo repository.Repository, assetData []types.AssetData, maspTxs []repository.MaspTx) ([]repository.MaspAsset, error) {
+	if len(assetData) == 0 && len(maspTxs) == 0 {
+		return nil, nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.load(ctx, repo); err != nil {
+		return nil, err
+	}
+
+	var result []repository.MaspAsset
+	added := make(map[masp.AssetType]bool)
+	addResult := func(assetType masp.AssetType, asset repository.MaspAsset) {
+		if !added[assetType] {
+			added[assetType] = true
+			result = append(result, asset)
+		}
+	}
+
+	for _, data := range assetData {
+		assetType, err := r.assetType(data)
+		if err != nil {
+			return nil, errors.New(err, "Compute asset type of "+data.Token.String())
+		}
+		asset := maspAsset(assetType, data)
+		r.assets[assetType] = asset
+		r.addToken(data.Token, data.Denom, asset.Epoch)
+		addResult(assetType, asset)
+	}
+
+	var assetTypes []string
+	for _, tx := range maspTxs {
+		for _, v := range tx.ValueBalance {
+			assetTypes = append(assetTypes, v.AssetType)
+		}
+		for _, v := range append(tx.TransparentInputs, tx.TransparentOutputs...) {
+			assetTypes = append(assetTypes, v.AssetType)
+		}
+	}
+
+	extended := false
+	for _, hexAssetType := range assetTypes {
+		var assetType masp.AssetType
+		if _, err := hex.Decode(assetType[:], []byte(hexAssetType)); err != nil {
+			return nil, errors.New(err, "Decode asset type")
+		}
+		if asset, ok := r.assets[assetType]; ok {
+			addResult(assetType, asset)
+			continue
+		}
+
+		if !extended {
+			if err := r.extendCandidates(); err != nil {
+				return nil, errors.New(err, "Compute MASP asset types")
+			}
+			extended = true
+		}
+		if asset, ok := r.candidates[assetType]; ok {
+			r.assets[assetType] = asset
+			addResult(assetType, asset)
+		}
+	}
+
+	return result, nil
+}
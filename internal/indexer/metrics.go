@@ -0,0 +1,64 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/metrics"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const rateWindow = 10 * time.Second
+
+type rateMeter struct {
+	start  time.Time
+	blocks int
+}
+
+func observeRPC(method, endpoint string, start time.Time) {
+	metrics.RPCDuration.WithLabelValues(method, endpoint).Observe(time.Since(start).Seconds())
+}
+
+// trackChainHeight periodically requests the latest height from the block source until ctx is done.
+func (i *Indexer) trackChainHeight(ctx context.Context) {
+	interval := time.Second * time.Duration(max(i.config.WaitForBlock, 1))
+	for {
+		latestHeight, err := i.source.LatestHeight(ctx)
+		if err != nil {
+			logger.Error("Get latest height failed", zap.Error(err))
+		} else {
+			i.chainHeight.Store(latestHeight)
+			metrics.ChainHeight.Set(float64(latestHeight))
+			metrics.Lag.Set(float64(latestHeight - i.indexedHeight.Load()))
+		}
+
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (i *Indexer) observeBlockSaved(height int64, blockTime time.Time) {
+	i.indexedHeight.Store(height)
+	i.lastBlockTime.Store(blockTime.UnixNano())
+
+	metrics.IndexedHeight.Set(float64(height))
+	metrics.BlocksProcessed.Inc()
+	if chainHeight := i.chainHeight.Load(); chainHeight != 0 {
+		metrics.Lag.Set(float64(max(chainHeight-height, 0)))
+	}
+
+	now := time.Now()
+	if i.rate.start.IsZero() {
+		i.rate.start = now
+	}
+	i.rate.blocks++
+	if elapsed := now.Sub(i.rate.start); elapsed >= rateWindow {
+		metrics.BlocksPerSecond.Set(float64(i.rate.blocks) / elapsed.Seconds())
+		i.rate = rateMeter{start: now}
+	}
+}
@@ -0,0 +1,106 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const (
+	defaultPruneInterval = 10 * time.Minute
+	// pruneBatch is the number of heights deleted in one database transaction.
+	pruneBatch = 1000
+)
+
+func (i *Indexer) pruningEnabled() bool {
+	return i.config.RetainBlocks > 0 || i.config.RetainDays > 0
+}
+
+// pruneOldBlocks periodically prunes blocks out of the retention window until ctx is done.
+func (i *Indexer) pruneOldBlocks(ctx context.Context) {
+	interval := defaultPruneInterval
+	if i.config.PruneInterval > 0 {
+		interval = time.Second * time.Duration(i.config.PruneInterval)
+	}
+	for {
+		select {
+		case <-time.After(interval):
+		case <-ctx.Done():
+			return
+		}
+
+		if err := i.Prune(ctx); err != nil {
+			logger.Error("Pruning failed", zap.Error(err))
+			i.errorCount.Add(1)
+		}
+	}
+}
+
+// pruneHeight returns the highest height which is out of the retention window.
+// The last indexed block is always kept.
+func (i *Indexer) pruneHeight(ctx context.Context, lastHeight int64) (int64, error) {
+	pruneTo := lastHeight - 1
+
+	if i.config.RetainBlocks > 0 {
+		pruneTo = min(pruneTo, lastHeight-i.config.RetainBlocks)
+	}
+
+	if i.config.RetainDays > 0 {
+		since := time.Now().AddDate(0, 0, -int(i.config.RetainDays))
+		height, err := i.repository.GetFirstHeightSince(ctx, since)
+		if err != nil && err != repository.ErrNotFound {
+			return 0, errors.New(err, "Get first height in retention window")
+		}
+		if err == nil {
+			pruneTo = min(pruneTo, height-1)
+		}
+	}
+
+	return pruneTo, nil
+}
+
+// Prune deletes blocks and their rows which are older than RetainBlocks blocks or RetainDays days.
+// Aggregates of deleted rows are kept, so totals served by the API don't change.
+func (i *Indexer) Prune(ctx context.Context) error {
+	if !i.pruningEnabled() {
+		return nil
+	}
+
+	lastHeight, err := i.repository.GetLastHeight(ctx)
+	if err != nil {
+		return errors.New(err, "Get last height")
+	}
+	earliestHeight, err := i.repository.GetEarliestHeight(ctx)
+	if err != nil {
+		return errors.New(err, "Get earliest height")
+	}
+	pruneTo, err := i.pruneHeight(ctx, lastHeight)
+	if err != nil {
+		return err
+	}
+
+	if earliestHeight == 0 || pruneTo < earliestHeight {
+		return nil
+	}
+
+	logger.Info("Pruning blocks", zap.Int64("from", earliestHeight), zap.Int64("to", pruneTo))
+
+	for from := earliestHeight; from <= pruneTo; from += pruneBatch {
+		to := min(from+pruneBatch-1, pruneTo)
+		err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+			return repo.PruneBlocks(txCtx, from, to, types.MASP_ADDR)
+		})
+		if err != nil {
+			return errors.New(err, "Prune blocks")
+		}
+		logger.Info("Blocks pruned", zap.Int64("from", from), zap.Int64("to", to))
+	}
+
+	return nil
+}
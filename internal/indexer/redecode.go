@@ -0,0 +1,207 @@
+package indexer
+
+import (
+	gobytes "bytes"
+	"compress/zlib"
+	"context"
+	"io"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// redecodeBatch is the number of heights which raw txs are loaded at once.
+const redecodeBatch = 1000
+
+func newRawTx(txHash, blockID []byte, height, txPos int64, txRawData []byte) (repository.RawTx, error) {
+	var buf gobytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(txRawData); err != nil {
+		return repository.RawTx{}, err
+	}
+	if err := w.Close(); err != nil {
+		return repository.RawTx{}, err
+	}
+	return repository.RawTx{
+		TxHash:      txHash,
+		BlockID:     blockID,
+		BlockHeight: height,
+		TxPos:       txPos,
+		Data:        buf.Bytes(),
+	}, nil
+}
+
+func decompressRawTx(rawTx repository.RawTx) ([]byte, error) {
+	r, err := zlib.NewReader(gobytes.NewReader(rawTx.Data))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// Redecode decodes stored raw txs in [fromHeight, toHeight] again and updates data of successful
+// decrypted txs and their account transactions. No RPC requests are made.
+func (i *Indexer) Redecode(ctx context.Context, fromHeight, toHeight int64) error {
+	lastSavedHeight, err := i.repository.GetLastHeight(ctx)
+	if err != nil {
+		return errors.New(err, "Get last height")
+	}
+	if toHeight == 0 || toHeight > lastSavedHeight {
+		toHeight = lastSavedHeight
+	}
+	if fromHeight < 1 {
+		fromHeight = 1
+	}
+	if fromHeight > toHeight {
+		return ErrInvalidRange
+	}
+
+	logger.Info("Redecoding txs", zap.Int64("from", fromHeight), zap.Int64("to", toHeight))
+
+	updated := 0
+
+	for batchFrom := fromHeight; batchFrom <= toHeight; batchFrom += redecodeBatch {
+		batchTo := min(batchFrom+redecodeBatch-1, toHeight)
+
+		rawTxs, err := i.repository.GetRawTxs(ctx, batchFrom, batchTo)
+		if err != nil {
+			return errors.New(err, "Get raw txs")
+		}
+
+		for start := 0; start < len(rawTxs); {
+			end := start + 1
+			for end < len(rawTxs) && rawTxs[end].BlockHeight == rawTxs[start].BlockHeight {
+				end++
+			}
+
+			cnt, err := i.redecodeBlock(ctx, rawTxs[start:end])
+			if err != nil {
+				return errors.New(err, "Redecode block txs")
+			}
+			updated += cnt
+
+			start = end
+		}
+
+		logger.Info("Txs redecoded", zap.Int64("from", batchFrom), zap.Int64("to", batchTo))
+	}
+
+	logger.Info("Redecoding finished", zap.Int("updated", updated))
+
+	return nil
+}
+
+// redecodeBlock processes raw txs of a single block.
+func (i *Indexer) redecodeBlock(ctx context.Context, rawTxs []repository.RawTx) (int, error) {
+	height := rawTxs[0].BlockHeight
+
+	storedTxs, err := i.repository.GetTxsBy(ctx, repository.TxFilter{BlockID: rawTxs[0].BlockID, Height: height})
+	if err != nil {
+		return 0, errors.New(err, "Get stored txs")
+	}
+	returnCodes := make(map[string]*int64, len(storedTxs))
+	for _, tx := range storedTxs {
+		returnCodes[string(tx.Hash)] = tx.ReturnCode
+	}
+
+	type update struct {
+		txHash []byte
+		data   []byte
+		rows   txRows
+	}
+	updates := make([]update, 0, len(rawTxs))
+
+	for _, rawTx := range rawTxs {
+		txRawData, err := decompressRawTx(rawTx)
+		if err != nil {
+			return 0, errors.New(err, "Decompress raw tx")
+		}
+
+		tx, err := i.decodeTxRawData(txRawData)
+		if err != nil {
+			logger.Error("Decode tx raw data failed", zap.Int64("height", height), zap.Int64("tx_id", rawTx.TxPos), zap.Error(err))
+			continue
+		}
+		if !tx.Header.TxType.IsDecrypted() {
+			continue
+		}
+
+		returnCode := returnCodes[string(rawTx.TxHash)]
+		if returnCode == nil || *returnCode != 0 {
+			continue
+		}
+
+		tx.BlockHeight = height
+		tx.TxPos = rawTx.TxPos
+		copy(tx.TxHash[:], rawTx.TxHash)
+
+		codeHash, err := tx.GetCodeHash()
+		if err != nil {
+			return 0, errors.New(err, "Get code hash")
+		}
+		tx.DecryptedTxType = i.decryptedTxType(height, codeHash)
+
+		data, rows, err := i.processSuccessTx(tx)
+		if err != nil {
+			logger.Error("Process success tx failed", zap.Int64("height", height), zap.Int64("tx_id", rawTx.TxPos), zap.Error(err))
+			continue
+		}
+
+		updates = append(updates, update{txHash: rawTx.TxHash, data: data, rows: rows})
+	}
+
+	var (
+		maspAssetData []types.AssetData
+		maspTxs       []repository.MaspTx
+	)
+	for _, u := range updates {
+		maspAssetData = append(maspAssetData, u.rows.maspAssetData...)
+		maspTxs = append(maspTxs, u.rows.maspTxs...)
+	}
+	maspAssets, err := i.maspAssets.resolve(ctx, i.repository, maspAssetData, maspTxs)
+	if err != nil {
+		return 0, errors.New(err, "Resolve MASP assets")
+	}
+
+	err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+		for _, u := range updates {
+			if err := updateTxPayload(txCtx, repo, height, u.txHash, u.data, u.rows); err != nil {
+				return err
+			}
+		}
+		return repo.AddMaspAssets(txCtx, maspAssets...)
+	})
+	if err != nil {
+		return 0, errors.New(err, "Update txs")
+	}
+
+	return len(updates), nil
+}
+
+// updateTxPayload replaces data of the stored tx and rows derived from it.
+func updateTxPayload(ctx context.Context, repo repository.Repository, height int64, txHash, data []byte, rows txRows) error {
+	if err := repo.UpdateTxData(ctx, height, txHash, data); err != nil {
+		return err
+	}
+	if err := repo.DeleteAccountTransactions(ctx, txHash); err != nil {
+		return err
+	}
+	if err := repo.AddAccountTransactions(ctx, rows.accountTxs...); err != nil {
+		return err
+	}
+	if err := repo.DeleteIbcTransfers(ctx, txHash); err != nil {
+		return err
+	}
+	if err := repo.AddIbcTransfers(ctx, rows.ibcTransfers...); err != nil {
+		return err
+	}
+	if err := repo.DeleteMaspTxs(ctx, txHash); err != nil {
+		return err
+	}
+	return repo.AddMaspTxs(ctx, rows.maspTxs...)
+}
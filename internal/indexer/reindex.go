@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	gobytes "bytes"
+	"context"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+var ErrInvalidRange = errors.Create("Invalid height range")
+
+// Reindex fetches blocks in [fromHeight, toHeight] again and replaces stored rows for these heights
+// in chunks of BulkBatchSize blocks, every chunk in its own database transaction. Only already indexed
+// heights can be reindexed, so it can run alongside the live indexer which only appends blocks above
+// its last saved height. If reindexing is interrupted, chunks replaced before stay saved.
+func (i *Indexer) Reindex(ctx context.Context, fromHeight, toHeight int64) error {
+	lastSavedHeight, err := i.repository.GetLastHeight(ctx)
+	if err != nil {
+		return errors.New(err, "Get last height")
+	}
+	if fromHeight < 1 || fromHeight > toHeight || toHeight > lastSavedHeight {
+		return ErrInvalidRange
+	}
+
+	prevBlock, err := i.loadProcessedBlock(ctx, i.repository, fromHeight-1)
+	if err != nil {
+		return errors.New(err, "Load previous block wrappers")
+	}
+
+	logger.Info("Reindexing blocks", zap.Int64("from", fromHeight), zap.Int64("to", toHeight))
+
+	// Assets registered from chunks which failed to replace must not stay in the registry
+	defer i.maspAssets.reset()
+
+	fetchCtx, cancelFetch := context.WithCancel(ctx)
+	defer cancelFetch()
+
+	blockChan := make(chan blockInfo, i.config.MaxBlocksInChannel)
+
+	i.wg.Add(1)
+	go func() {
+		defer i.wg.Done()
+		defer close(blockChan)
+		i.blockFetcher(fetchCtx, fromHeight, toHeight, blockChan)
+	}()
+
+	chunkSize := int64(max(i.config.BulkBatchSize, 1))
+	datas := make([]blockData, 0, min(chunkSize, toHeight-fromHeight+1))
+	nextHeight := fromHeight
+
+	for blockInfo := range blockChan {
+		height := blockInfo.resultBlock.Block.Height
+
+		// The first block of a chunk is checked against stored blocks, which include the previous chunk
+		lastBlockID := blockInfo.resultBlock.Block.Header.LastBlockID.Hash
+		if len(datas) == 0 {
+			if err = i.checkParent(ctx, height, lastBlockID); err != nil {
+				return err
+			}
+		} else if !gobytes.Equal(lastBlockID, datas[len(datas)-1].block.BlockID) {
+			return ErrChainDiverged
+		}
+
+		data, err := i.buildBlockData(blockInfo.resultBlock, blockInfo.resultBlockResults, prevBlock)
+		if err != nil {
+			return errors.New(err, "Build block data")
+		}
+		data.maspAssets, err = i.maspAssets.resolve(ctx, i.repository, data.maspAssetData, data.maspTxs)
+		if err != nil {
+			return errors.New(err, "Resolve MASP assets")
+		}
+		datas = append(datas, data)
+
+		prevBlock = processedBlock{
+			height:   height,
+			wrappers: wrapperHashes(data.txs, data.failedTxs),
+		}
+		nextHeight = height + 1
+
+		logger.Info("Block reindexed", zap.Int64("height", height))
+
+		if int64(len(datas)) == chunkSize || height == toHeight {
+			if err = i.replaceBlocks(ctx, datas); err != nil {
+				return errors.New(err, "Replace blocks")
+			}
+			datas = datas[:0]
+		}
+	}
+
+	if nextHeight != toHeight+1 {
+		return errors.Create("Fetching blocks interrupted")
+	}
+
+	logger.Info("Reindexing finished", zap.Int64("from", fromHeight), zap.Int64("to", toHeight))
+
+	return nil
+}
+
+// replaceBlocks deletes stored blocks of heights of datas and saves datas in a single database transaction.
+// The stored block following datas must be the child of their last block, so the stored chain stays linked.
+func (i *Indexer) replaceBlocks(ctx context.Context, datas []blockData) error {
+	first, last := datas[0].block, datas[len(datas)-1].block
+
+	return i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+		child, err := repo.GetBlockBy(txCtx, repository.BlockFilter{Height: last.HeaderHeight + 1})
+		if err != nil && err != repository.ErrNotFound {
+			return errors.New(err, "Get child block")
+		}
+		if err == nil && !gobytes.Equal(child.HeaderLastBlockIDHash, last.BlockID) {
+			logger.Error("Child block mismatch", zap.Int64("height", child.HeaderHeight),
+				zap.String("last_block_id", bytes.HexBytes(child.HeaderLastBlockIDHash).String()),
+				zap.String("block_id", bytes.HexBytes(last.BlockID).String()))
+			return ErrChainDiverged
+		}
+
+		if err := repo.DeleteBlocks(txCtx, first.HeaderHeight, last.HeaderHeight); err != nil {
+			return err
+		}
+		return saveBlocksData(txCtx, repo, datas...)
+	})
+}
@@ -0,0 +1,72 @@
+package indexer
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+const (
+	SourceHTTP      = "http"
+	SourceWebsocket = "websocket"
+	SourceFile      = "file"
+)
+
+// ErrSourceExhausted is returned by BlockSource.WaitForHeight when the height will never be available.
+var ErrSourceExhausted = errors.Create("Block source exhausted")
+
+// BlockSource provides blocks with their results to the indexer.
+type BlockSource interface {
+	// Block returns the block at the height or ErrBlockNotFound if it isn't available yet.
+	Block(ctx context.Context, height int64) (*coretypes.ResultBlock, error)
+	// BlockResults returns results of the block at the height or ErrBlockNotFound if they aren't available yet.
+	BlockResults(ctx context.Context, height int64) (*coretypes.ResultBlockResults, error)
+	// LatestHeight returns height of the latest available block.
+	LatestHeight(ctx context.Context) (int64, error)
+	// WaitForHeight is called after attempt failed requests of the height and blocks until it's worth to retry.
+	WaitForHeight(ctx context.Context, height int64, attempt int) error
+	Close() error
+}
+
+func newBlockSource(config Config) (BlockSource, error) {
+	switch config.Source {
+	case SourceHTTP, "":
+		return newPoolSource(config)
+	case SourceWebsocket:
+		pool, err := newPoolSource(config)
+		if err != nil {
+			return nil, err
+		}
+		return newWebsocketSource(pool, config.WaitForBlock)
+	case SourceFile:
+		return newFileSource(config.SourceDir)
+	default:
+		return nil, errors.Create("unknown block source: " + config.Source)
+	}
+}
+
+const minRetryDelay = 250 * time.Millisecond
+
+// retryDelay doubles the delay with every attempt up to maxDelay. The result is randomized in [delay/2, delay]
+// so workers waiting for the same block don't hit the node at the same moment.
+func retryDelay(attempt int, maxDelay time.Duration) time.Duration {
+	maxDelay = max(maxDelay, minRetryDelay)
+	delay := maxDelay
+	if attempt < 32 {
+		delay = min(minRetryDelay<<attempt, maxDelay)
+	}
+	return delay/2 + rand.N(delay/2+1)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
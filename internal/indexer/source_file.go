@@ -0,0 +1,111 @@
+package indexer
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+const (
+	blockFileSuffix        = ".block.json"
+	blockResultsFileSuffix = ".block_results.json"
+)
+
+// fileSource replays blocks dumped to a directory as <height>.block.json and <height>.block_results.json
+// files with the results of block and block_results RPC methods.
+type fileSource struct {
+	dir          string
+	latestHeight int64
+}
+
+func newFileSource(dir string) (*fileSource, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, errors.New(err, "Read source dir")
+	}
+
+	s := &fileSource{dir: dir}
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), blockFileSuffix)
+		if !ok {
+			continue
+		}
+		height, err := strconv.ParseInt(name, 10, 64)
+		if err != nil {
+			continue
+		}
+		s.latestHeight = max(s.latestHeight, height)
+	}
+
+	return s, nil
+}
+
+func blockFileName(dir string, height int64, suffix string) string {
+	return filepath.Join(dir, strconv.FormatInt(height, 10)+suffix)
+}
+
+func (s *fileSource) Block(_ context.Context, height int64) (*coretypes.ResultBlock, error) {
+	var resultBlock coretypes.ResultBlock
+	if err := s.read(height, blockFileSuffix, &resultBlock); err != nil {
+		return nil, err
+	}
+	return &resultBlock, nil
+}
+
+func (s *fileSource) BlockResults(_ context.Context, height int64) (*coretypes.ResultBlockResults, error) {
+	var resultBlockResults coretypes.ResultBlockResults
+	if err := s.read(height, blockResultsFileSuffix, &resultBlockResults); err != nil {
+		return nil, err
+	}
+	return &resultBlockResults, nil
+}
+
+func (s *fileSource) read(height int64, suffix string, v any) error {
+	bs, err := os.ReadFile(blockFileName(s.dir, height, suffix))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrBlockNotFound
+	}
+	if err != nil {
+		return errors.New(err, "Read file")
+	}
+	return errors.New(tmjson.Unmarshal(bs, v), "Decode file")
+}
+
+func (s *fileSource) LatestHeight(_ context.Context) (int64, error) {
+	return s.latestHeight, nil
+}
+
+// WaitForHeight never waits since files don't appear during replay.
+func (s *fileSource) WaitForHeight(_ context.Context, _ int64, _ int) error {
+	return ErrSourceExhausted
+}
+
+func (s *fileSource) Close() error {
+	return nil
+}
+
+// writeBlockFiles dumps the block in the format read by fileSource.
+func writeBlockFiles(dir string, info blockInfo) error {
+	height := info.resultBlock.Block.Height
+
+	bs, err := tmjson.Marshal(info.resultBlock)
+	if err != nil {
+		return errors.New(err, "Encode block")
+	}
+	if err = os.WriteFile(blockFileName(dir, height, blockFileSuffix), bs, 0o644); err != nil {
+		return errors.New(err, "Write block file")
+	}
+
+	bs, err = tmjson.Marshal(info.resultBlockResults)
+	if err != nil {
+		return errors.New(err, "Encode block results")
+	}
+	return errors.New(os.WriteFile(blockFileName(dir, height, blockResultsFileSuffix), bs, 0o644), "Write block results file")
+}
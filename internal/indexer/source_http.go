@@ -0,0 +1,84 @@
+package indexer
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// httpSource requests blocks from a single node RPC endpoint.
+type httpSource struct {
+	client   *rpchttp.HTTP
+	endpoint string
+	maxDelay time.Duration
+}
+
+func newHTTPSource(rpcURL string, maxDelay time.Duration) (*httpSource, error) {
+	client, err := rpchttp.New(rpcURL)
+	if err != nil {
+		return nil, errors.New(err, "Init client")
+	}
+	return &httpSource{
+		client:   client,
+		endpoint: endpointLabel(rpcURL),
+		maxDelay: maxDelay,
+	}, nil
+}
+
+// endpointLabel strips credentials and path from the RPC URL to use it in logs and metrics.
+func endpointLabel(rpcURL string) string {
+	u, err := url.Parse(rpcURL)
+	if err != nil || u.Host == "" {
+		return rpcURL
+	}
+	return u.Host
+}
+
+func (s *httpSource) Block(ctx context.Context, height int64) (*coretypes.ResultBlock, error) {
+	defer observeRPC("block", s.endpoint, time.Now())
+	resultBlock, err := s.client.Block(ctx, &height)
+	return resultBlock, checkNotFoundError(err)
+}
+
+func (s *httpSource) BlockResults(ctx context.Context, height int64) (*coretypes.ResultBlockResults, error) {
+	defer observeRPC("block_results", s.endpoint, time.Now())
+	resultBlockResults, err := s.client.BlockResults(ctx, &height)
+	return resultBlockResults, checkNotFoundError(err)
+}
+
+func (s *httpSource) Status(ctx context.Context) (*coretypes.ResultStatus, error) {
+	defer observeRPC("status", s.endpoint, time.Now())
+	return s.client.Status(ctx)
+}
+
+func (s *httpSource) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := s.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (s *httpSource) WaitForHeight(ctx context.Context, _ int64, attempt int) error {
+	return sleep(ctx, retryDelay(attempt, s.maxDelay))
+}
+
+func (s *httpSource) Close() error {
+	return nil
+}
+
+func checkNotFoundError(err error) error {
+	var rpcErr *rpctypes.RPCError
+	if errors.As(err, &rpcErr) {
+		if rpcErr.Code == -32603 {
+			return ErrBlockNotFound
+		}
+	}
+	return err
+}
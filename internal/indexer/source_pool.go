@@ -0,0 +1,227 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/metrics"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const (
+	defaultHealthCheckInterval = 10 * time.Second
+	healthCheckTimeout         = 5 * time.Second
+)
+
+type endpoint struct {
+	*httpSource
+
+	healthy atomic.Bool
+	height  atomic.Int64
+}
+
+// poolSource requests blocks from several RPC endpoints. Endpoints are checked periodically and
+// requests go to healthy endpoints in the configured order, failing over to the next one on errors.
+// An endpoint is healthy if it responds to status, isn't catching up and isn't lagging behind
+// the highest endpoint by more than maxHeightLag blocks.
+type poolSource struct {
+	endpoints    []*endpoint
+	maxDelay     time.Duration
+	maxHeightLag int64
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newPoolSource(config Config) (*poolSource, error) {
+	if len(config.RpcURLs) == 0 {
+		return nil, errors.Create("no rpc endpoints configured")
+	}
+
+	maxDelay := time.Second * time.Duration(config.WaitForBlock)
+
+	p := &poolSource{
+		maxDelay:     maxDelay,
+		maxHeightLag: config.MaxHeightLag,
+		done:         make(chan struct{}),
+	}
+	for _, rpcURL := range config.RpcURLs {
+		source, err := newHTTPSource(rpcURL, maxDelay)
+		if err != nil {
+			return nil, errors.New(err, "Init endpoint", rpcURL)
+		}
+		e := &endpoint{httpSource: source}
+		e.healthy.Store(true)
+		p.endpoints = append(p.endpoints, e)
+	}
+
+	interval := time.Second * time.Duration(config.HealthCheckInterval)
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.cancel = cancel
+
+	p.checkHealth(ctx)
+
+	go func() {
+		defer close(p.done)
+		for {
+			select {
+			case <-time.After(interval):
+				p.checkHealth(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return p, nil
+}
+
+func (p *poolSource) checkHealth(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, healthCheckTimeout)
+	defer cancel()
+
+	statuses := make([]*coretypes.ResultStatus, len(p.endpoints))
+
+	var wg sync.WaitGroup
+	for idx, e := range p.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status, err := e.Status(ctx)
+			if err != nil {
+				logger.Warn("Endpoint health check failed", zap.String("endpoint", e.endpoint), zap.Error(err))
+				metrics.RPCErrors.WithLabelValues(e.endpoint).Inc()
+				return
+			}
+			statuses[idx] = status
+		}()
+	}
+	wg.Wait()
+
+	maxHeight := int64(0)
+	for _, status := range statuses {
+		if status != nil && !status.SyncInfo.CatchingUp {
+			maxHeight = max(maxHeight, status.SyncInfo.LatestBlockHeight)
+		}
+	}
+
+	for idx, e := range p.endpoints {
+		status := statuses[idx]
+
+		healthy := status != nil && !status.SyncInfo.CatchingUp
+		if status != nil {
+			e.height.Store(status.SyncInfo.LatestBlockHeight)
+			metrics.EndpointHeight.WithLabelValues(e.endpoint).Set(float64(status.SyncInfo.LatestBlockHeight))
+			if p.maxHeightLag > 0 && maxHeight-status.SyncInfo.LatestBlockHeight > p.maxHeightLag {
+				healthy = false
+			}
+		}
+
+		if e.healthy.Swap(healthy) != healthy {
+			logger.Info("Endpoint health changed", zap.String("endpoint", e.endpoint), zap.Bool("healthy", healthy))
+		}
+		if healthy {
+			metrics.EndpointHealthy.WithLabelValues(e.endpoint).Set(1)
+		} else {
+			metrics.EndpointHealthy.WithLabelValues(e.endpoint).Set(0)
+		}
+	}
+}
+
+// candidates returns healthy endpoints which already have the height followed by the rest of healthy endpoints.
+// Unhealthy endpoints are used only if there are no healthy ones.
+func (p *poolSource) candidates(height int64) []*endpoint {
+	var ready, behind, unhealthy []*endpoint
+	for _, e := range p.endpoints {
+		switch {
+		case !e.healthy.Load():
+			unhealthy = append(unhealthy, e)
+		case height == 0 || e.height.Load() >= height:
+			ready = append(ready, e)
+		default:
+			behind = append(behind, e)
+		}
+	}
+	ready = append(ready, behind...)
+	if len(ready) == 0 {
+		return unhealthy
+	}
+	return ready
+}
+
+// do runs the request on candidate endpoints until one of them succeeds. Endpoints failing with errors
+// other than ErrBlockNotFound are marked unhealthy until the next health check.
+func (p *poolSource) do(ctx context.Context, height int64, request func(e *endpoint) error) error {
+	var err error
+	for _, e := range p.candidates(height) {
+		err = request(e)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+		if !errors.Is(err, ErrBlockNotFound) {
+			metrics.RPCErrors.WithLabelValues(e.endpoint).Inc()
+			if e.healthy.Swap(false) {
+				logger.Warn("Endpoint request failed, failing over", zap.String("endpoint", e.endpoint), zap.Error(err))
+				metrics.EndpointHealthy.WithLabelValues(e.endpoint).Set(0)
+			}
+		}
+	}
+	return err
+}
+
+func (p *poolSource) Block(ctx context.Context, height int64) (*coretypes.ResultBlock, error) {
+	var resultBlock *coretypes.ResultBlock
+	err := p.do(ctx, height, func(e *endpoint) error {
+		var err error
+		resultBlock, err = e.Block(ctx, height)
+		if err == nil {
+			metrics.BlocksServed.WithLabelValues(e.endpoint).Inc()
+		}
+		return err
+	})
+	return resultBlock, err
+}
+
+func (p *poolSource) BlockResults(ctx context.Context, height int64) (*coretypes.ResultBlockResults, error) {
+	var resultBlockResults *coretypes.ResultBlockResults
+	err := p.do(ctx, height, func(e *endpoint) error {
+		var err error
+		resultBlockResults, err = e.BlockResults(ctx, height)
+		return err
+	})
+	return resultBlockResults, err
+}
+
+func (p *poolSource) LatestHeight(ctx context.Context) (int64, error) {
+	latestHeight := int64(0)
+	err := p.do(ctx, 0, func(e *endpoint) error {
+		height, err := e.LatestHeight(ctx)
+		if err != nil {
+			return err
+		}
+		e.height.Store(height)
+		latestHeight = height
+		return nil
+	})
+	return latestHeight, err
+}
+
+func (p *poolSource) WaitForHeight(ctx context.Context, _ int64, attempt int) error {
+	return sleep(ctx, retryDelay(attempt, p.maxDelay))
+}
+
+func (p *poolSource) Close() error {
+	p.cancel()
+	<-p.done
+	return nil
+}
@@ -0,0 +1,147 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	rpctypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+
+	"github.com/the-laziest/namadexer-go/internal/repository/memory"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func TestFileSource(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	chain := testChain(4, "", 0)
+	for _, info := range chain {
+		if err := writeBlockFiles(dir, info); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	source, err := newFileSource(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	latestHeight, err := source.LatestHeight(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if latestHeight != 4 {
+		t.Fatalf("latest height %d, want 4", latestHeight)
+	}
+
+	if _, err = source.Block(ctx, 5); !errors.Is(err, ErrBlockNotFound) {
+		t.Fatalf("missing block error %v, want %v", err, ErrBlockNotFound)
+	}
+	if err = source.WaitForHeight(ctx, 5, 0); !errors.Is(err, ErrSourceExhausted) {
+		t.Fatalf("wait error %v, want %v", err, ErrSourceExhausted)
+	}
+
+	repo := memory.NewRepository()
+	if err = runIndexer(t, testIndexer(t, source, repo, DecodeFailureHalt)); err != nil {
+		t.Fatal(err)
+	}
+	checkStoredChain(t, repo, chain)
+}
+
+// rpcServer serves blocks of the chain by JSON-RPC or fails all requests with the status.
+func rpcServer(t *testing.T, chain map[int64]blockInfo, status int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+
+		var request rpctypes.RPCRequest
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var params struct {
+			Height int64 `json:"height,string"`
+		}
+		if err := json.Unmarshal(request.Params, &params); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		response := rpctypes.RPCInternalError(request.ID, ErrBlockNotFound)
+		if info, ok := chain[params.Height]; ok && request.Method == "block" {
+			response = rpctypes.NewRPCSuccessResponse(request.ID, info.resultBlock)
+		}
+		if err := json.NewEncoder(w).Encode(response); err != nil {
+			t.Error(err)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestPoolSourceFailover(t *testing.T) {
+	chain := testChain(3, "", 0)
+
+	tests := []struct {
+		name        string
+		statuses    []int
+		height      int64
+		wantErr     bool
+		wantHealthy []bool
+	}{
+		{"first endpoint serves", []int{http.StatusOK, http.StatusOK}, 2, false, []bool{true, true}},
+		{"failover to second endpoint", []int{http.StatusInternalServerError, http.StatusOK}, 2, false, []bool{false, true}},
+		{"failover over several endpoints", []int{http.StatusBadGateway, http.StatusInternalServerError, http.StatusOK}, 2, false, []bool{false, false, true}},
+		{"block not found isn't a failure", []int{http.StatusOK, http.StatusOK}, 5, true, []bool{true, true}},
+		{"all endpoints fail", []int{http.StatusInternalServerError, http.StatusBadGateway}, 2, true, []bool{false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+
+			pool := &poolSource{maxDelay: time.Second}
+			for _, status := range tt.statuses {
+				source, err := newHTTPSource(rpcServer(t, chain, status).URL, time.Second)
+				if err != nil {
+					t.Fatal(err)
+				}
+				e := &endpoint{httpSource: source}
+				e.healthy.Store(true)
+				pool.endpoints = append(pool.endpoints, e)
+			}
+
+			resultBlock, err := pool.Block(ctx, tt.height)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error %v, want error %v", err, tt.wantErr)
+			}
+			if err == nil && !slices.Equal(resultBlock.BlockID.Hash, chain[tt.height].resultBlock.BlockID.Hash) {
+				t.Fatalf("block %X, want %X", resultBlock.BlockID.Hash, chain[tt.height].resultBlock.BlockID.Hash)
+			}
+
+			healthy := make([]bool, 0, len(pool.endpoints))
+			for _, e := range pool.endpoints {
+				healthy = append(healthy, e.healthy.Load())
+			}
+			if !slices.Equal(healthy, tt.wantHealthy) {
+				t.Fatalf("healthy endpoints %v, want %v", healthy, tt.wantHealthy)
+			}
+
+			// Healthy endpoints are tried first, so the next request doesn't hit failed endpoints
+			if !tt.wantErr {
+				if candidates := pool.candidates(tt.height); !candidates[0].healthy.Load() {
+					t.Fatalf("first candidate %s is unhealthy", candidates[0].endpoint)
+				}
+			}
+		})
+	}
+}
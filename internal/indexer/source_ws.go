@@ -0,0 +1,170 @@
+package indexer
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	coretypes "github.com/tendermint/tendermint/rpc/coretypes"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const wsSubscriber = "namadexer"
+
+// wsStaleAfter is the time without NewBlock events after which the subscription is considered broken
+// and the latest height is requested from RPC endpoints again.
+const wsStaleAfter = time.Minute
+
+// websocketSource requests blocks from RPC endpoints the same way as poolSource but is notified about
+// new blocks by NewBlock subscription to the first endpoint, so the indexer doesn't sleep while waiting
+// for the next block.
+type websocketSource struct {
+	*poolSource
+
+	client       *rpchttp.HTTP
+	waitForBlock time.Duration
+
+	latestHeight atomic.Int64
+	// lastEventAt is the unix nano time of the last NewBlock event
+	lastEventAt atomic.Int64
+
+	mu       sync.Mutex
+	newBlock chan struct{}
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func newWebsocketSource(pool *poolSource, waitForBlock int64) (*websocketSource, error) {
+	client := pool.endpoints[0].client
+
+	if err := client.Start(); err != nil {
+		_ = pool.Close()
+		return nil, errors.New(err, "Start websocket client")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	events, err := client.Subscribe(ctx, wsSubscriber, tmtypes.EventQueryNewBlock.String())
+	if err != nil {
+		cancel()
+		_ = client.Stop()
+		_ = pool.Close()
+		return nil, errors.New(err, "Subscribe to new blocks")
+	}
+
+	s := &websocketSource{
+		poolSource:   pool,
+		client:       client,
+		waitForBlock: time.Second * time.Duration(max(waitForBlock, 1)),
+		newBlock:     make(chan struct{}),
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+
+	go s.listen(ctx, events)
+
+	return s, nil
+}
+
+// listen handles NewBlock events until ctx is done and subscribes again if the events channel is closed.
+func (s *websocketSource) listen(ctx context.Context, events <-chan coretypes.ResultEvent) {
+	defer close(s.done)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-events:
+			if !ok {
+				// Heights are requested from RPC endpoints until the subscription is restored
+				s.latestHeight.Store(0)
+				logger.Warn("New blocks subscription closed, resubscribing")
+				if events = s.resubscribe(ctx); events == nil {
+					return
+				}
+				continue
+			}
+			data, ok := event.Data.(tmtypes.EventDataNewBlock)
+			if !ok || data.Block == nil {
+				continue
+			}
+			s.latestHeight.Store(data.Block.Height)
+			s.lastEventAt.Store(time.Now().UnixNano())
+			s.notify()
+		}
+	}
+}
+
+// resubscribe retries NewBlock subscription every WaitForBlock seconds, it returns nil if ctx is done.
+func (s *websocketSource) resubscribe(ctx context.Context) <-chan coretypes.ResultEvent {
+	for {
+		events, err := s.client.Subscribe(ctx, wsSubscriber, tmtypes.EventQueryNewBlock.String())
+		if err == nil {
+			return events
+		}
+		logger.Error("Subscribe to new blocks failed", zap.Error(err))
+
+		select {
+		case <-time.After(s.waitForBlock):
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (s *websocketSource) notify() {
+	s.mu.Lock()
+	close(s.newBlock)
+	s.newBlock = make(chan struct{})
+	s.mu.Unlock()
+}
+
+// LatestHeight returns the height of the last NewBlock event unless the subscription is closed
+// or no events were received for wsStaleAfter.
+func (s *websocketSource) LatestHeight(ctx context.Context) (int64, error) {
+	height := s.latestHeight.Load()
+	if height != 0 && time.Since(time.Unix(0, s.lastEventAt.Load())) < wsStaleAfter {
+		return height, nil
+	}
+	return s.poolSource.LatestHeight(ctx)
+}
+
+// WaitForHeight waits for the NewBlock event if the height isn't produced yet. It falls back to polling
+// after WaitForBlock seconds, so a broken subscription only increases latency.
+func (s *websocketSource) WaitForHeight(ctx context.Context, height int64, attempt int) error {
+	s.mu.Lock()
+	newBlock := s.newBlock
+	s.mu.Unlock()
+
+	// The height already exists, so the request failed for another reason
+	if s.latestHeight.Load() >= height {
+		return s.poolSource.WaitForHeight(ctx, height, attempt)
+	}
+
+	select {
+	case <-newBlock:
+		return nil
+	case <-time.After(s.waitForBlock):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (s *websocketSource) Close() error {
+	s.cancel()
+	<-s.done
+	if err := s.client.UnsubscribeAll(context.Background(), wsSubscriber); err != nil {
+		logger.Error("Unsubscribe from new blocks failed", zap.Error(err))
+	}
+	if err := s.client.Stop(); err != nil {
+		logger.Error("Stopping websocket client failed", zap.Error(err))
+	}
+	return s.poolSource.Close()
+}
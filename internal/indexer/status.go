@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/version"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const (
+	statusInterval = repository.IndexerStatusInterval
+	// syncedLag is the maximum number of blocks behind the chain tip at which the indexer is considered synced
+	syncedLag = 2
+)
+
+func (i *Indexer) setSyncState(state string) {
+	i.syncState.Store(state)
+}
+
+func (i *Indexer) status() repository.IndexerStatus {
+	status := repository.IndexerStatus{
+		IndexedHeight: i.indexedHeight.Load(),
+		ChainHeight:   i.chainHeight.Load(),
+		SyncState:     repository.SyncStateStarting,
+		Version:       version.String(),
+		Checksums:     i.config.Checksums.Fingerprint(),
+		ErrorCount:    i.errorCount.Load(),
+		UpdatedAt:     time.Now(),
+	}
+	if state, ok := i.syncState.Load().(string); ok {
+		status.SyncState = state
+	}
+	if status.SyncState == repository.SyncStateSyncing && status.IndexedHeight != 0 &&
+		status.ChainHeight-status.IndexedHeight <= syncedLag {
+		status.SyncState = repository.SyncStateSynced
+	}
+	if blockTime := i.lastBlockTime.Load(); blockTime != 0 {
+		t := time.Unix(0, blockTime)
+		status.LastBlockTime = &t
+	}
+	return status
+}
+
+func (i *Indexer) saveStatus(ctx context.Context) {
+	if err := i.repository.SaveIndexerStatus(ctx, i.status()); err != nil {
+		logger.Error("Save indexer status failed", zap.Error(err))
+	}
+}
+
+// reportStatus periodically saves the indexer status to the database until ctx is done.
+func (i *Indexer) reportStatus(ctx context.Context) {
+	for {
+		i.saveStatus(ctx)
+
+		select {
+		case <-time.After(statusInterval):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// saveFinalStatus saves the status after Start has finished, when its context may already be cancelled.
+func (i *Indexer) saveFinalStatus(err error) {
+	if err != nil {
+		i.errorCount.Add(1)
+		i.setSyncState(repository.SyncStateFailed)
+	} else {
+		i.setSyncState(repository.SyncStateStopped)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), statusInterval)
+	defer cancel()
+	i.saveStatus(ctx)
+}
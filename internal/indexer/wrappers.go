@@ -0,0 +1,174 @@
+package indexer
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// wrapperHashes returns hashes of wrapper txs in the block order. Decrypted txs of a block are executed in the same order
+// as wrapper txs were included into the previous block, so the n-th decrypted tx belongs to the n-th wrapper.
+// Quarantined wrappers keep their places, with a nil hash if they can't be decoded.
+func wrapperHashes(txs []repository.Transaction, failedTxs []repository.FailedTx) [][]byte {
+	type wrapper struct {
+		pos  int64
+		hash []byte
+	}
+	wrappers := make([]wrapper, 0, len(txs))
+	for _, tx := range txs {
+		if tx.TxType == "Wrapper" {
+			wrappers = append(wrappers, wrapper{pos: tx.PosInBlock, hash: tx.Hash})
+		}
+	}
+	for _, tx := range failedTxs {
+		if tx.TxType == "Wrapper" {
+			wrappers = append(wrappers, wrapper{pos: tx.TxPos, hash: tx.TxHash})
+		}
+	}
+	slices.SortFunc(wrappers, func(a, b wrapper) int {
+		return cmp.Compare(a.pos, b.pos)
+	})
+
+	hashes := make([][]byte, 0, len(wrappers))
+	for _, w := range wrappers {
+		hashes = append(hashes, w.hash)
+	}
+	return hashes
+}
+
+// decryptedIndexes maps positions of decrypted txs in the block to their indexes among decrypted txs of the block,
+// quarantined decrypted txs included, so they are matched with wrappers the same way as during indexing.
+func decryptedIndexes(txs []repository.Transaction, failedTxs []repository.FailedTx) map[int64]int {
+	var positions []int64
+	for _, tx := range txs {
+		if tx.TxType == "Decrypted" {
+			positions = append(positions, tx.PosInBlock)
+		}
+	}
+	for _, tx := range failedTxs {
+		if tx.TxType == "Decrypted" {
+			positions = append(positions, tx.TxPos)
+		}
+	}
+	slices.Sort(positions)
+
+	// A decrypted tx saved without data is quarantined too, so its position can appear twice
+	indexes := make(map[int64]int, len(positions))
+	for _, pos := range slices.Compact(positions) {
+		indexes[pos] = len(indexes)
+	}
+	return indexes
+}
+
+// getBlockTxs returns stored txs of the block at the given height with the given type ordered by position in block.
+func getBlockTxs(ctx context.Context, repo repository.Repository, height int64, txType string) ([]repository.Transaction, error) {
+	block, err := repo.GetBlockBy(ctx, repository.BlockFilter{Height: height})
+	if err == repository.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.New(err, "Get block")
+	}
+
+	txs, err := repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID, Height: block.HeaderHeight, TxType: txType})
+	if err != nil {
+		return nil, errors.New(err, "Get block txs")
+	}
+
+	slices.SortFunc(txs, func(a, b repository.Transaction) int {
+		return int(a.PosInBlock - b.PosInBlock)
+	})
+
+	return txs, nil
+}
+
+// loadProcessedBlock restores wrappers of already saved block, so decrypted txs of the next block can be linked to them.
+func (i *Indexer) loadProcessedBlock(ctx context.Context, repo repository.Repository, height int64) (processedBlock, error) {
+	if height <= 0 {
+		return processedBlock{}, nil
+	}
+
+	txs, err := getBlockTxs(ctx, repo, height, "Wrapper")
+	if err != nil {
+		return processedBlock{}, err
+	}
+
+	failedTxs, err := i.getBlockFailedTxs(ctx, repo, height)
+	if err != nil {
+		return processedBlock{}, err
+	}
+
+	return processedBlock{
+		height:   height,
+		wrappers: wrapperHashes(txs, failedTxs),
+	}, nil
+}
+
+// RepairWrapperIDs backfills missing wrapper ids of decrypted txs which were saved without them.
+func (i *Indexer) RepairWrapperIDs(ctx context.Context) error {
+	heights, err := i.repository.GetHeightsWithoutWrapperIDs(ctx)
+	if err != nil {
+		return errors.New(err, "Get heights without wrapper ids")
+	}
+
+	logger.Info("Repairing wrapper ids", zap.Int("blocks", len(heights)))
+
+	repaired := 0
+
+	for _, height := range heights {
+		err = i.repository.RunInTransaction(ctx, func(txCtx context.Context, repo repository.Repository) error {
+			cnt, err := i.repairBlockWrapperIDs(txCtx, repo, height)
+			repaired += cnt
+			return err
+		})
+		if err != nil {
+			return errors.New(err, "Update wrapper ids")
+		}
+
+		logger.Info("Wrapper ids repaired", zap.Int64("height", height))
+	}
+
+	logger.Info("Repairing wrapper ids finished", zap.Int("txs", repaired))
+
+	return nil
+}
+
+// repairBlockWrapperIDs sets missing wrapper ids of decrypted txs of the block at the given height and returns
+// the number of updated txs.
+func (i *Indexer) repairBlockWrapperIDs(ctx context.Context, repo repository.Repository, height int64) (int, error) {
+	prevBlock, err := i.loadProcessedBlock(ctx, repo, height-1)
+	if err != nil {
+		return 0, errors.New(err, "Load previous block wrappers")
+	}
+
+	decrypted, err := getBlockTxs(ctx, repo, height, "Decrypted")
+	if err != nil {
+		return 0, err
+	}
+
+	failedTxs, err := i.getBlockFailedTxs(ctx, repo, height)
+	if err != nil {
+		return 0, err
+	}
+	indexes := decryptedIndexes(decrypted, failedTxs)
+
+	repaired := 0
+	for _, tx := range decrypted {
+		id := indexes[tx.PosInBlock]
+		if len(tx.WrapperID) != 0 || id >= len(prevBlock.wrappers) || prevBlock.wrappers[id] == nil {
+			continue
+		}
+		if err := repo.UpdateWrapperID(ctx, tx.BlockHeight, tx.Hash, prevBlock.wrappers[id]); err != nil {
+			return repaired, err
+		}
+		repaired++
+	}
+
+	return repaired, nil
+}
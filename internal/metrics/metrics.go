@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+const namespace = "namadexer"
+
+// Indexer metrics
+var (
+	ChainHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "chain_height",
+		Help:      "Latest block height reported by the node.",
+	})
+	IndexedHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "indexed_height",
+		Help:      "Latest block height saved to the database.",
+	})
+	Lag = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "lag_blocks",
+		Help:      "Number of blocks between the chain tip and the latest indexed block.",
+	})
+	BlocksPerSecond = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "blocks_per_second",
+		Help:      "Block processing rate.",
+	})
+	BlocksProcessed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "blocks_processed_total",
+		Help:      "Number of processed blocks.",
+	})
+	TxsProcessed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "txs_processed_total",
+		Help:      "Number of processed txs by type.",
+	}, []string{"tx_type"})
+	DecodeFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "decode_failures_total",
+		Help:      "Number of txs which failed to be decoded.",
+	})
+	QuarantinedTxs = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "quarantined_txs_total",
+		Help:      "Number of txs saved to failed txs instead of halting the indexer.",
+	})
+	RPCDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "rpc_duration_seconds",
+		Help:      "Duration of RPC requests to the node.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "endpoint"})
+	RPCErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "rpc_errors_total",
+		Help:      "Number of failed RPC requests by endpoint.",
+	}, []string{"endpoint"})
+	BlocksServed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "blocks_served_total",
+		Help:      "Number of blocks received from each RPC endpoint.",
+	}, []string{"endpoint"})
+	EndpointHealthy = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "endpoint_healthy",
+		Help:      "Whether the RPC endpoint passed the last health check.",
+	}, []string{"endpoint"})
+	EndpointHeight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "indexer",
+		Name:      "endpoint_height",
+		Help:      "Latest block height reported by the RPC endpoint.",
+	}, []string{"endpoint"})
+)
+
+// Server metrics
+var (
+	Requests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "server",
+		Name:      "requests_total",
+		Help:      "Number of handled requests by route and status code.",
+	}, []string{"route", "code"})
+	RequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "server",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of request handling by route.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route"})
+)
+
+// Repository metrics
+var (
+	QueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "repository",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of repository method calls.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+type Config struct {
+	Host string
+	Port string
+}
+
+// Serve exposes /metrics on the configured address in background. Returns nil if port is not configured.
+func Serve(config Config) *http.Server {
+	if config.Port == "" {
+		return nil
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+
+	server := &http.Server{
+		Addr:    net.JoinHostPort(config.Host, config.Port),
+		Handler: mux,
+	}
+
+	go func() {
+		logger.Info("Starting metrics server", zap.String("addr", server.Addr))
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Metrics server failed", zap.Error(err))
+		}
+	}()
+
+	return server
+}
@@ -1,6 +1,16 @@
 package repository
 
+const (
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite"
+)
+
 type Config struct {
+	// Driver selects the database backend, postgres by default.
+	Driver string
+	// Path is the database file of the sqlite driver.
+	Path string
+
 	Host     string
 	Port     string
 	User     string
@@ -8,6 +18,22 @@ type Config struct {
 	DbName   string
 
 	Schema            string
-	CreateIndex       bool
 	ConnectionTimeout int
+	// PartitionSize is the number of heights in one partition of transactions and commit signatures,
+	// 0 disables partitioning.
+	PartitionSize int64
+
+	// Replicas serve read queries of the API, writes and transactions always go to the primary.
+	Replicas []ReplicaConfig
+	// MaxReplicaLag is the replication lag in seconds above which a replica isn't queried, 0 means no limit.
+	MaxReplicaLag int64
+}
+
+// ReplicaConfig is a read-only copy of the primary database, empty fields are taken from the primary.
+type ReplicaConfig struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	DbName   string
 }
@@ -0,0 +1,39 @@
+package database
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/repository/postgres"
+	"github.com/the-laziest/namadexer-go/internal/repository/sqlite"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// Database is a repository backend which manages its own schema.
+type Database interface {
+	repository.Repository
+	repository.Migrator
+}
+
+// Open connects to the database of the configured driver.
+func Open(ctx context.Context, config repository.Config) (Database, error) {
+	switch config.Driver {
+	case "", repository.DriverPostgres:
+		db, err := postgres.NewRepository(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	case repository.DriverSQLite:
+		if config.Path == "" {
+			return nil, errors.Create("Database path is required for sqlite driver")
+		}
+		db, err := sqlite.NewRepository(ctx, config)
+		if err != nil {
+			return nil, err
+		}
+		return db, nil
+	default:
+		return nil, errors.Create("Unknown database driver: " + config.Driver)
+	}
+}
@@ -0,0 +1,284 @@
+package instrumented
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/the-laziest/namadexer-go/internal/metrics"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+// instrumented wraps repository and observes duration of every method call.
+type instrumented struct {
+	repo repository.Repository
+}
+
+func New(repo repository.Repository) repository.Repository {
+	return &instrumented{repo}
+}
+
+func observe(method string, start time.Time) {
+	metrics.QueryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
+
+func (i *instrumented) AddBlock(ctx context.Context, block repository.Block) error {
+	defer observe("AddBlock", time.Now())
+	return i.repo.AddBlock(ctx, block)
+}
+
+func (i *instrumented) GetBlockBy(ctx context.Context, filter repository.BlockFilter) (repository.Block, error) {
+	defer observe("GetBlockBy", time.Now())
+	return i.repo.GetBlockBy(ctx, filter)
+}
+
+func (i *instrumented) GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*repository.Block, error) {
+	defer observe("GetLatestBlocks", time.Now())
+	return i.repo.GetLatestBlocks(ctx, cnt, offset)
+}
+
+func (i *instrumented) DeleteBlocks(ctx context.Context, fromHeight, toHeight int64) error {
+	defer observe("DeleteBlocks", time.Now())
+	return i.repo.DeleteBlocks(ctx, fromHeight, toHeight)
+}
+
+func (i *instrumented) AddTransactions(ctx context.Context, txs ...repository.Transaction) error {
+	defer observe("AddTransactions", time.Now())
+	return i.repo.AddTransactions(ctx, txs...)
+}
+
+func (i *instrumented) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter) (uint64, error) {
+	defer observe("GetTotalTxsBy", time.Now())
+	return i.repo.GetTotalTxsBy(ctx, filter)
+}
+
+func (i *instrumented) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]repository.Transaction, error) {
+	defer observe("GetTxsBy", time.Now())
+	return i.repo.GetTxsBy(ctx, filter)
+}
+
+func (i *instrumented) GetTxsBySourceOrTarget(ctx context.Context, address string) ([]repository.Transaction, error) {
+	defer observe("GetTxsBySourceOrTarget", time.Now())
+	return i.repo.GetTxsBySourceOrTarget(ctx, address)
+}
+
+func (i *instrumented) GetVoteProposalDatas(ctx context.Context, voteCodes [][]byte, proposalID int64) ([]json.RawMessage, error) {
+	defer observe("GetVoteProposalDatas", time.Now())
+	return i.repo.GetVoteProposalDatas(ctx, voteCodes, proposalID)
+}
+
+func (i *instrumented) GetHeightsWithoutWrapperIDs(ctx context.Context) ([]int64, error) {
+	defer observe("GetHeightsWithoutWrapperIDs", time.Now())
+	return i.repo.GetHeightsWithoutWrapperIDs(ctx)
+}
+
+func (i *instrumented) UpdateWrapperID(ctx context.Context, blockHeight int64, txHash, wrapperID []byte) error {
+	defer observe("UpdateWrapperID", time.Now())
+	return i.repo.UpdateWrapperID(ctx, blockHeight, txHash, wrapperID)
+}
+
+func (i *instrumented) UpdateTxData(ctx context.Context, blockHeight int64, txHash []byte, data []byte) error {
+	defer observe("UpdateTxData", time.Now())
+	return i.repo.UpdateTxData(ctx, blockHeight, txHash, data)
+}
+
+func (i *instrumented) GetFeeStats(ctx context.Context, filter repository.FeeStatsFilter) ([]repository.FeeStats, error) {
+	defer observe("GetFeeStats", time.Now())
+	return i.repo.GetFeeStats(ctx, filter)
+}
+
+func (i *instrumented) AddRawTxs(ctx context.Context, txs ...repository.RawTx) error {
+	defer observe("AddRawTxs", time.Now())
+	return i.repo.AddRawTxs(ctx, txs...)
+}
+
+func (i *instrumented) GetRawTxs(ctx context.Context, fromHeight, toHeight int64) ([]repository.RawTx, error) {
+	defer observe("GetRawTxs", time.Now())
+	return i.repo.GetRawTxs(ctx, fromHeight, toHeight)
+}
+
+func (i *instrumented) AddFailedTxs(ctx context.Context, txs ...repository.FailedTx) error {
+	defer observe("AddFailedTxs", time.Now())
+	return i.repo.AddFailedTxs(ctx, txs...)
+}
+
+func (i *instrumented) GetFailedTxs(ctx context.Context, limit, offset uint64) ([]repository.FailedTx, error) {
+	defer observe("GetFailedTxs", time.Now())
+	return i.repo.GetFailedTxs(ctx, limit, offset)
+}
+
+func (i *instrumented) GetFailedTxHeights(ctx context.Context) ([]int64, error) {
+	defer observe("GetFailedTxHeights", time.Now())
+	return i.repo.GetFailedTxHeights(ctx)
+}
+
+func (i *instrumented) GetBlockFailedTxs(ctx context.Context, height int64) ([]repository.FailedTx, error) {
+	defer observe("GetBlockFailedTxs", time.Now())
+	return i.repo.GetBlockFailedTxs(ctx, height)
+}
+
+func (i *instrumented) DeleteFailedTx(ctx context.Context, height, txPos int64) error {
+	defer observe("DeleteFailedTx", time.Now())
+	return i.repo.DeleteFailedTx(ctx, height, txPos)
+}
+
+func (i *instrumented) AddAccountTransactions(ctx context.Context, txs ...repository.AccountTransaction) error {
+	defer observe("AddAccountTransactions", time.Now())
+	return i.repo.AddAccountTransactions(ctx, txs...)
+}
+
+func (i *instrumented) GetTotalAccountTxs(ctx context.Context, address []byte, role string) (uint64, error) {
+	defer observe("GetTotalAccountTxs", time.Now())
+	return i.repo.GetTotalAccountTxs(ctx, address, role)
+}
+
+func (i *instrumented) GetAccountTxs(ctx context.Context, address []byte, role string, limit, offset uint64) ([][]byte, error) {
+	defer observe("GetAccountTxs", time.Now())
+	return i.repo.GetAccountTxs(ctx, address, role, limit, offset)
+}
+
+func (i *instrumented) DeleteAccountTransactions(ctx context.Context, txHash []byte) error {
+	defer observe("DeleteAccountTransactions", time.Now())
+	return i.repo.DeleteAccountTransactions(ctx, txHash)
+}
+
+func (i *instrumented) AddIbcTransfers(ctx context.Context, transfers ...repository.IbcTransfer) error {
+	defer observe("AddIbcTransfers", time.Now())
+	return i.repo.AddIbcTransfers(ctx, transfers...)
+}
+
+func (i *instrumented) GetIbcTransfers(ctx context.Context, filter repository.IbcTransferFilter) ([]repository.IbcTransfer, error) {
+	defer observe("GetIbcTransfers", time.Now())
+	return i.repo.GetIbcTransfers(ctx, filter)
+}
+
+func (i *instrumented) DeleteIbcTransfers(ctx context.Context, txHash []byte) error {
+	defer observe("DeleteIbcTransfers", time.Now())
+	return i.repo.DeleteIbcTransfers(ctx, txHash)
+}
+
+func (i *instrumented) AddMaspTxs(ctx context.Context, txs ...repository.MaspTx) error {
+	defer observe("AddMaspTxs", time.Now())
+	return i.repo.AddMaspTxs(ctx, txs...)
+}
+
+func (i *instrumented) GetMaspTxs(ctx context.Context, txHash []byte) ([]repository.MaspTx, error) {
+	defer observe("GetMaspTxs", time.Now())
+	return i.repo.GetMaspTxs(ctx, txHash)
+}
+
+func (i *instrumented) DeleteMaspTxs(ctx context.Context, txHash []byte) error {
+	defer observe("DeleteMaspTxs", time.Now())
+	return i.repo.DeleteMaspTxs(ctx, txHash)
+}
+
+func (i *instrumented) AddMaspAssets(ctx context.Context, assets ...repository.MaspAsset) error {
+	defer observe("AddMaspAssets", time.Now())
+	return i.repo.AddMaspAssets(ctx, assets...)
+}
+
+func (i *instrumented) GetMaspAssets(ctx context.Context, assetTypes ...[]byte) ([]repository.MaspAsset, error) {
+	defer observe("GetMaspAssets", time.Now())
+	return i.repo.GetMaspAssets(ctx, assetTypes...)
+}
+
+func (i *instrumented) GetAccountThresholds(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*uint8, error) {
+	defer observe("GetAccountThresholds", time.Now())
+	return i.repo.GetAccountThresholds(ctx, updateAccountCodes, accountID)
+}
+
+func (i *instrumented) GetAccountVPCodes(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*string, error) {
+	defer observe("GetAccountVPCodes", time.Now())
+	return i.repo.GetAccountVPCodes(ctx, updateAccountCodes, accountID)
+}
+
+func (i *instrumented) GetAccountPublicKeys(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([][]string, error) {
+	defer observe("GetAccountPublicKeys", time.Now())
+	return i.repo.GetAccountPublicKeys(ctx, updateAccountCodes, accountID)
+}
+
+func (i *instrumented) AddCommitSignatures(ctx context.Context, signatures ...repository.CommitSignature) error {
+	defer observe("AddCommitSignatures", time.Now())
+	return i.repo.AddCommitSignatures(ctx, signatures...)
+}
+
+func (i *instrumented) GetCommitsCount(ctx context.Context, validatorAddress []byte, start, end int64) (int64, error) {
+	defer observe("GetCommitsCount", time.Now())
+	return i.repo.GetCommitsCount(ctx, validatorAddress, start, end)
+}
+
+func (i *instrumented) AddEvidences(ctx context.Context, evidences ...repository.Evidence) error {
+	defer observe("AddEvidences", time.Now())
+	return i.repo.AddEvidences(ctx, evidences...)
+}
+
+func (i *instrumented) AddBlockEvents(ctx context.Context, events ...repository.BlockEvent) error {
+	defer observe("AddBlockEvents", time.Now())
+	return i.repo.AddBlockEvents(ctx, events...)
+}
+
+func (i *instrumented) GetBlockEvents(ctx context.Context, filter repository.EventFilter) ([]repository.BlockEvent, error) {
+	defer observe("GetBlockEvents", time.Now())
+	return i.repo.GetBlockEvents(ctx, filter)
+}
+
+func (i *instrumented) GetLastHeight(ctx context.Context) (int64, error) {
+	defer observe("GetLastHeight", time.Now())
+	return i.repo.GetLastHeight(ctx)
+}
+
+func (i *instrumented) GetEarliestHeight(ctx context.Context) (int64, error) {
+	defer observe("GetEarliestHeight", time.Now())
+	return i.repo.GetEarliestHeight(ctx)
+}
+
+func (i *instrumented) GetFirstHeightSince(ctx context.Context, t time.Time) (int64, error) {
+	defer observe("GetFirstHeightSince", time.Now())
+	return i.repo.GetFirstHeightSince(ctx, t)
+}
+
+func (i *instrumented) PruneBlocks(ctx context.Context, fromHeight, toHeight int64, maspAddress string) error {
+	defer observe("PruneBlocks", time.Now())
+	return i.repo.PruneBlocks(ctx, fromHeight, toHeight, maspAddress)
+}
+
+func (i *instrumented) GetPrunedShielded(ctx context.Context) ([]repository.TokenAmount, error) {
+	defer observe("GetPrunedShielded", time.Now())
+	return i.repo.GetPrunedShielded(ctx)
+}
+
+func (i *instrumented) EnsurePartitions(ctx context.Context, height int64) error {
+	defer observe("EnsurePartitions", time.Now())
+	return i.repo.EnsurePartitions(ctx, height)
+}
+
+func (i *instrumented) SaveIndexerStatus(ctx context.Context, status repository.IndexerStatus) error {
+	defer observe("SaveIndexerStatus", time.Now())
+	return i.repo.SaveIndexerStatus(ctx, status)
+}
+
+func (i *instrumented) GetIndexerStatus(ctx context.Context) (repository.IndexerStatus, error) {
+	defer observe("GetIndexerStatus", time.Now())
+	return i.repo.GetIndexerStatus(ctx)
+}
+
+func (i *instrumented) Ping(ctx context.Context) error {
+	defer observe("Ping", time.Now())
+	return i.repo.Ping(ctx)
+}
+
+func (i *instrumented) GetReplicasStatus(ctx context.Context) ([]repository.ReplicaStatus, error) {
+	defer observe("GetReplicasStatus", time.Now())
+	return i.repo.GetReplicasStatus(ctx)
+}
+
+func (i *instrumented) RunInTransaction(ctx context.Context, txFunc repository.InTransaction) error {
+	defer observe("RunInTransaction", time.Now())
+	return i.repo.RunInTransaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+		return txFunc(ctx, &instrumented{tx})
+	})
+}
+
+func (i *instrumented) Close() error {
+	return i.repo.Close()
+}
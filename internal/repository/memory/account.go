@@ -0,0 +1,149 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (m *memory) AddAccountTransactions(ctx context.Context, txs ...repository.AccountTransaction) error {
+	defer m.lock()()
+
+	m.data.accountTransactions = append(m.data.accountTransactions, txs...)
+
+	return nil
+}
+
+// accountTxs returns txs of the address with the role or with any role if it's empty, latest first.
+// A tx is returned once if the address has several roles in it.
+func (m *memory) accountTxs(address []byte, role string) []repository.AccountTransaction {
+	var txs []repository.AccountTransaction
+	for _, tx := range m.data.accountTransactions {
+		if tx.Address != string(address) || (role != "" && tx.Role != role) {
+			continue
+		}
+		if !slices.ContainsFunc(txs, func(added repository.AccountTransaction) bool { return bytes.Equal(added.TxHash, tx.TxHash) }) {
+			txs = append(txs, tx)
+		}
+	}
+	slices.SortStableFunc(txs, func(a, b repository.AccountTransaction) int {
+		return cmp.Or(cmp.Compare(b.BlockHeight, a.BlockHeight), cmp.Compare(b.TxPos, a.TxPos))
+	})
+	return txs
+}
+
+// GetTotalAccountTxs counts txs of pruned blocks only if role is empty, pruned totals are not split by roles.
+func (m *memory) GetTotalAccountTxs(ctx context.Context, address []byte, role string) (uint64, error) {
+	defer m.rlock()()
+
+	total := uint64(len(m.accountTxs(address, role)))
+	if role == "" {
+		total += m.data.prunedAccountTotals[string(address)]
+	}
+
+	return total, nil
+}
+
+func (m *memory) GetAccountTxs(ctx context.Context, address []byte, role string, limit, offset uint64) ([][]byte, error) {
+	defer m.rlock()()
+
+	var txHashes [][]byte
+	for _, tx := range page(m.accountTxs(address, role), limit, offset) {
+		txHashes = append(txHashes, tx.TxHash)
+	}
+
+	return txHashes, nil
+}
+
+func (m *memory) DeleteAccountTransactions(ctx context.Context, txHash []byte) error {
+	defer m.lock()()
+
+	m.data.accountTransactions = slices.DeleteFunc(m.data.accountTransactions, func(tx repository.AccountTransaction) bool {
+		return bytes.Equal(tx.TxHash, txHash)
+	})
+
+	return nil
+}
+
+// accountUpdates returns datas of txs with update account codes for the account.
+func (m *memory) accountUpdates(updateAccountCodes [][]byte, accountID string) [][]byte {
+	var datas [][]byte
+	for _, tx := range m.data.transactions {
+		if !containsBytes(updateAccountCodes, tx.Code) {
+			continue
+		}
+		if address, ok := jsonText(tx.Data, "address"); ok && address == accountID {
+			datas = append(datas, tx.Data)
+		}
+	}
+	return datas
+}
+
+func (m *memory) GetAccountThresholds(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*uint8, error) {
+	defer m.rlock()()
+
+	var thresholds []*uint8
+	for _, data := range m.accountUpdates(updateAccountCodes, accountID) {
+		var threshold *uint8
+		if value, ok := jsonText(data, "threshold"); ok {
+			parsed, err := strconv.ParseUint(value, 10, 8)
+			if err != nil {
+				return nil, errors.New(err, "Parse threshold")
+			}
+			t := uint8(parsed)
+			threshold = &t
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, nil
+}
+
+func (m *memory) GetAccountVPCodes(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*string, error) {
+	defer m.rlock()()
+
+	var vpCodes []*string
+	for _, data := range m.accountUpdates(updateAccountCodes, accountID) {
+		var vpCode *string
+		if value, ok := jsonText(data, "vp_code_hash"); ok {
+			vpCode = &value
+		}
+		vpCodes = append(vpCodes, vpCode)
+	}
+
+	return vpCodes, nil
+}
+
+func (m *memory) GetAccountPublicKeys(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([][]string, error) {
+	defer m.rlock()()
+
+	var publicKeys [][]string
+	for _, data := range m.accountUpdates(updateAccountCodes, accountID) {
+		var object map[string]json.RawMessage
+		if err := json.Unmarshal(data, &object); err != nil {
+			return nil, errors.New(err, "Unmarshal account update")
+		}
+
+		var elements []json.RawMessage
+		if value, ok := object["public_keys"]; ok && string(value) != "null" {
+			if err := json.Unmarshal(value, &elements); err != nil {
+				return nil, errors.New(err, "Unmarshal public keys")
+			}
+		}
+
+		keys := []string{}
+		for _, element := range elements {
+			key, _ := rawText(element)
+			keys = append(keys, key)
+		}
+		publicKeys = append(publicKeys, keys)
+	}
+
+	return publicKeys, nil
+}
@@ -0,0 +1,136 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (m *memory) AddBlock(ctx context.Context, block repository.Block) error {
+	defer m.lock()()
+
+	for _, b := range m.data.blocks {
+		if bytes.Equal(b.BlockID, block.BlockID) || b.HeaderHeight == block.HeaderHeight {
+			return errors.Create("Block already exists")
+		}
+	}
+
+	m.data.blocks = append(m.data.blocks, block)
+
+	return nil
+}
+
+func (m *memory) block(blockID []byte) (repository.Block, bool) {
+	for _, b := range m.data.blocks {
+		if bytes.Equal(b.BlockID, blockID) {
+			return b, true
+		}
+	}
+	return repository.Block{}, false
+}
+
+func (m *memory) GetLastHeight(ctx context.Context) (int64, error) {
+	defer m.rlock()()
+
+	var height int64
+	for _, b := range m.data.blocks {
+		height = max(height, b.HeaderHeight)
+	}
+
+	return height, nil
+}
+
+func (m *memory) GetBlockBy(ctx context.Context, filter repository.BlockFilter) (repository.Block, error) {
+	defer m.rlock()()
+
+	for _, b := range m.data.blocks {
+		if filter.Height != 0 && b.HeaderHeight != filter.Height {
+			continue
+		}
+		if len(filter.BlockID) != 0 && !bytes.Equal(b.BlockID, filter.BlockID) {
+			continue
+		}
+		return b, nil
+	}
+
+	return repository.Block{}, repository.ErrNotFound
+}
+
+func (m *memory) GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*repository.Block, error) {
+	defer m.rlock()()
+
+	blocks := slices.Clone(m.data.blocks)
+	slices.SortFunc(blocks, func(a, b repository.Block) int { return cmp.Compare(b.HeaderHeight, a.HeaderHeight) })
+
+	var result []*repository.Block
+	for _, block := range page(blocks, cnt, offset) {
+		result = append(result, &block)
+	}
+
+	return result, nil
+}
+
+// DeleteBlocks removes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+func (m *memory) DeleteBlocks(ctx context.Context, fromHeight, toHeight int64) error {
+	defer m.lock()()
+
+	m.deleteBlocks(fromHeight, toHeight)
+
+	return nil
+}
+
+func (m *memory) deleteBlocks(fromHeight, toHeight int64) {
+	inRange := func(height int64) bool { return height >= fromHeight && height <= toHeight }
+
+	d := m.data
+	d.transactions = slices.DeleteFunc(d.transactions, func(tx repository.Transaction) bool { return inRange(tx.BlockHeight) })
+	d.commitSignatures = slices.DeleteFunc(d.commitSignatures, func(s repository.CommitSignature) bool { return inRange(s.BlockHeight) })
+	d.accountTransactions = slices.DeleteFunc(d.accountTransactions, func(tx repository.AccountTransaction) bool { return inRange(tx.BlockHeight) })
+	d.rawTxs = slices.DeleteFunc(d.rawTxs, func(tx repository.RawTx) bool { return inRange(tx.BlockHeight) })
+	d.failedTxs = slices.DeleteFunc(d.failedTxs, func(tx repository.FailedTx) bool { return inRange(tx.BlockHeight) })
+	d.blockEvents = slices.DeleteFunc(d.blockEvents, func(e repository.BlockEvent) bool { return inRange(e.BlockHeight) })
+	d.ibcTransfers = slices.DeleteFunc(d.ibcTransfers, func(t repository.IbcTransfer) bool { return inRange(t.BlockHeight) })
+	d.maspTxs = slices.DeleteFunc(d.maspTxs, func(t repository.MaspTx) bool { return inRange(t.BlockHeight) })
+
+	d.evidences = slices.DeleteFunc(d.evidences, func(e repository.Evidence) bool {
+		block, ok := m.block(e.BlockID)
+		return ok && inRange(block.HeaderHeight)
+	})
+	d.blocks = slices.DeleteFunc(d.blocks, func(b repository.Block) bool { return inRange(b.HeaderHeight) })
+}
+
+// GetEarliestHeight returns the lowest stored height, 0 if there are no blocks.
+func (m *memory) GetEarliestHeight(ctx context.Context) (int64, error) {
+	defer m.rlock()()
+
+	var height int64
+	for _, b := range m.data.blocks {
+		if height == 0 || b.HeaderHeight < height {
+			height = b.HeaderHeight
+		}
+	}
+
+	return height, nil
+}
+
+// GetFirstHeightSince returns the lowest height of blocks created at t or later.
+func (m *memory) GetFirstHeightSince(ctx context.Context, t time.Time) (int64, error) {
+	defer m.rlock()()
+
+	var height int64
+	for _, b := range m.data.blocks {
+		if !b.HeaderTime.Before(t) && (height == 0 || b.HeaderHeight < height) {
+			height = b.HeaderHeight
+		}
+	}
+	if height == 0 {
+		return 0, repository.ErrNotFound
+	}
+
+	return height, nil
+}
@@ -0,0 +1,29 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddCommitSignatures(ctx context.Context, signatures ...repository.CommitSignature) error {
+	defer m.lock()()
+
+	m.data.commitSignatures = append(m.data.commitSignatures, signatures...)
+
+	return nil
+}
+
+func (m *memory) GetCommitsCount(ctx context.Context, validatorAddress []byte, start, end int64) (int64, error) {
+	defer m.rlock()()
+
+	var cnt int64
+	for _, signature := range m.data.commitSignatures {
+		if bytes.Equal(signature.ValidatorAddress, validatorAddress) && signature.BlockHeight >= start && signature.BlockHeight <= end {
+			cnt++
+		}
+	}
+
+	return cnt, nil
+}
@@ -0,0 +1,55 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddBlockEvents(ctx context.Context, events ...repository.BlockEvent) error {
+	defer m.lock()()
+
+	m.data.blockEvents = append(m.data.blockEvents, events...)
+
+	return nil
+}
+
+func matchEvent(event repository.BlockEvent, filter repository.EventFilter) bool {
+	if filter.Type != "" && event.Type != filter.Type {
+		return false
+	}
+	if len(filter.TxHash) != 0 && !bytes.Equal(event.TxHash, filter.TxHash) {
+		return false
+	}
+	if filter.FromHeight > 0 && event.BlockHeight < filter.FromHeight {
+		return false
+	}
+	if filter.ToHeight > 0 && event.BlockHeight > filter.ToHeight {
+		return false
+	}
+	if filter.Key != "" {
+		return slices.ContainsFunc(event.Attributes, func(attribute repository.EventAttribute) bool {
+			return attribute.Key == filter.Key && (filter.Value == "" || attribute.Value == filter.Value)
+		})
+	}
+	return true
+}
+
+func (m *memory) GetBlockEvents(ctx context.Context, filter repository.EventFilter) ([]repository.BlockEvent, error) {
+	defer m.rlock()()
+
+	var events []repository.BlockEvent
+	for _, event := range m.data.blockEvents {
+		if matchEvent(event, filter) {
+			events = append(events, event)
+		}
+	}
+	slices.SortStableFunc(events, func(a, b repository.BlockEvent) int {
+		return cmp.Or(cmp.Compare(b.BlockHeight, a.BlockHeight), cmp.Compare(a.EventPos, b.EventPos))
+	})
+
+	return page(events, filter.Limit, filter.Offset), nil
+}
@@ -0,0 +1,15 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddEvidences(ctx context.Context, evidences ...repository.Evidence) error {
+	defer m.lock()()
+
+	m.data.evidences = append(m.data.evidences, evidences...)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddFailedTxs(ctx context.Context, txs ...repository.FailedTx) error {
+	defer m.lock()()
+
+	m.data.failedTxs = append(m.data.failedTxs, txs...)
+
+	return nil
+}
+
+func (m *memory) GetFailedTxs(ctx context.Context, limit, offset uint64) ([]repository.FailedTx, error) {
+	defer m.rlock()()
+
+	txs := slices.Clone(m.data.failedTxs)
+	slices.SortStableFunc(txs, func(a, b repository.FailedTx) int {
+		return cmp.Or(cmp.Compare(b.BlockHeight, a.BlockHeight), cmp.Compare(b.TxPos, a.TxPos))
+	})
+
+	return page(txs, limit, offset), nil
+}
+
+func (m *memory) GetFailedTxHeights(ctx context.Context) ([]int64, error) {
+	defer m.rlock()()
+
+	var heights []int64
+	for _, tx := range m.data.failedTxs {
+		heights = append(heights, tx.BlockHeight)
+	}
+	slices.Sort(heights)
+
+	return slices.Compact(heights), nil
+}
+
+func (m *memory) GetBlockFailedTxs(ctx context.Context, height int64) ([]repository.FailedTx, error) {
+	defer m.rlock()()
+
+	var txs []repository.FailedTx
+	for _, tx := range m.data.failedTxs {
+		if tx.BlockHeight == height {
+			txs = append(txs, tx)
+		}
+	}
+	slices.SortStableFunc(txs, func(a, b repository.FailedTx) int {
+		return cmp.Compare(a.TxPos, b.TxPos)
+	})
+
+	return txs, nil
+}
+
+func (m *memory) DeleteFailedTx(ctx context.Context, height, txPos int64) error {
+	defer m.lock()()
+
+	m.data.failedTxs = slices.DeleteFunc(m.data.failedTxs, func(tx repository.FailedTx) bool {
+		return tx.BlockHeight == height && tx.TxPos == txPos
+	})
+
+	return nil
+}
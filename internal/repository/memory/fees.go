@@ -0,0 +1,150 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// GetFeeStats aggregates fees of wrapper txs. Gas used is taken from the decrypted tx of the wrapper
+// if it was executed and from the wrapper itself otherwise. Decrypted txs are always in the block
+// following their wrapper. Aggregates of pruned blocks are added to stats grouped by day or token
+// if no height range is given.
+func (m *memory) GetFeeStats(ctx context.Context, filter repository.FeeStatsFilter) ([]repository.FeeStats, error) {
+	switch filter.GroupBy {
+	case repository.FeeGroupByBlock, repository.FeeGroupByDay, repository.FeeGroupByToken:
+	default:
+		return nil, errors.Create("unknown fee stats grouping: " + filter.GroupBy)
+	}
+
+	defer m.rlock()()
+
+	type group struct {
+		stats    repository.FeeStats
+		feesPaid []string
+	}
+	var groups []*group
+	groupOf := func(key repository.FeeStats) *group {
+		i := slices.IndexFunc(groups, func(g *group) bool {
+			return g.stats.Height == key.Height && g.stats.Day.Equal(key.Day) && g.stats.FeeToken == key.FeeToken
+		})
+		if i < 0 {
+			groups = append(groups, &group{stats: key})
+			i = len(groups) - 1
+		}
+		return groups[i]
+	}
+
+	for _, w := range m.data.transactions {
+		if w.FeePaid == nil {
+			continue
+		}
+		if filter.FeeToken != "" && w.FeeToken != filter.FeeToken {
+			continue
+		}
+		if filter.FeePayer != "" && w.FeePayer != filter.FeePayer {
+			continue
+		}
+		if filter.FromHeight > 0 && w.BlockHeight < filter.FromHeight {
+			continue
+		}
+		if filter.ToHeight > 0 && w.BlockHeight > filter.ToHeight {
+			continue
+		}
+		block, ok := m.block(w.BlockID)
+		if !ok {
+			continue
+		}
+
+		key := repository.FeeStats{FeeToken: w.FeeToken}
+		switch filter.GroupBy {
+		case repository.FeeGroupByBlock:
+			key.Height = w.BlockHeight
+		case repository.FeeGroupByDay:
+			key.Day = block.HeaderTime.UTC().Truncate(24 * time.Hour)
+		}
+
+		g := groupOf(key)
+		fees, feesPaid := m.wrapperFees(w)
+		g.stats.TxsCount += fees.TxsCount
+		g.stats.GasLimit += fees.GasLimit
+		g.stats.GasUsed += fees.GasUsed
+		g.feesPaid = append(g.feesPaid, feesPaid...)
+	}
+
+	if filter.GroupBy != repository.FeeGroupByBlock && filter.FromHeight == 0 && filter.ToHeight == 0 {
+		for _, pruned := range m.data.prunedFees {
+			if filter.FeeToken != "" && pruned.FeeToken != filter.FeeToken {
+				continue
+			}
+			if filter.FeePayer != "" && pruned.feePayer != filter.FeePayer {
+				continue
+			}
+
+			key := repository.FeeStats{FeeToken: pruned.FeeToken}
+			if filter.GroupBy == repository.FeeGroupByDay {
+				key.Day = pruned.Day
+			}
+
+			g := groupOf(key)
+			g.stats.TxsCount += pruned.TxsCount
+			g.stats.GasLimit += pruned.GasLimit
+			g.stats.GasUsed += pruned.GasUsed
+			g.feesPaid = append(g.feesPaid, pruned.FeePaid)
+		}
+	}
+
+	result := make([]repository.FeeStats, 0, len(groups))
+	for _, g := range groups {
+		feePaid, err := addDecimals(g.feesPaid...)
+		if err != nil {
+			return nil, errors.New(err, "Sum fees paid")
+		}
+		g.stats.FeePaid = feePaid
+		result = append(result, g.stats)
+	}
+
+	slices.SortFunc(result, func(a, b repository.FeeStats) int {
+		return cmp.Or(cmp.Compare(b.Height, a.Height), b.Day.Compare(a.Day), cmp.Compare(a.FeeToken, b.FeeToken))
+	})
+
+	return page(result, filter.Limit, filter.Offset), nil
+}
+
+// wrapperFees returns txs count, gas and fees paid of the wrapper tx w with fee paid. Like LEFT JOIN
+// the wrapper is counted once per decrypted tx or once without it.
+func (m *memory) wrapperFees(w repository.Transaction) (repository.FeeStats, []string) {
+	var decrypted []repository.Transaction
+	for _, d := range m.data.transactions {
+		if d.BlockHeight == w.BlockHeight+1 && bytes.Equal(d.WrapperID, w.Hash) {
+			decrypted = append(decrypted, d)
+		}
+	}
+	if len(decrypted) == 0 {
+		decrypted = append(decrypted, repository.Transaction{})
+	}
+
+	var (
+		stats    repository.FeeStats
+		feesPaid []string
+	)
+	for _, d := range decrypted {
+		stats.TxsCount++
+		if w.GasLimitMultiplier != nil {
+			stats.GasLimit += *w.GasLimitMultiplier
+		}
+		if d.GasUsed != nil {
+			stats.GasUsed += *d.GasUsed
+		} else if w.GasUsed != nil {
+			stats.GasUsed += *w.GasUsed
+		}
+		feesPaid = append(feesPaid, *w.FeePaid)
+	}
+
+	return stats, feesPaid
+}
@@ -0,0 +1,48 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddIbcTransfers(ctx context.Context, transfers ...repository.IbcTransfer) error {
+	defer m.lock()()
+
+	m.data.ibcTransfers = append(m.data.ibcTransfers, transfers...)
+
+	return nil
+}
+
+func (m *memory) GetIbcTransfers(ctx context.Context, filter repository.IbcTransferFilter) ([]repository.IbcTransfer, error) {
+	defer m.rlock()()
+
+	var transfers []repository.IbcTransfer
+	for _, t := range m.data.ibcTransfers {
+		if filter.Channel != "" && t.SourceChannel != filter.Channel && t.DestinationChannel != filter.Channel {
+			continue
+		}
+		if filter.Denom != "" && t.Denom != filter.Denom {
+			continue
+		}
+		transfers = append(transfers, t)
+	}
+	slices.SortStableFunc(transfers, func(a, b repository.IbcTransfer) int {
+		return cmp.Or(cmp.Compare(b.BlockHeight, a.BlockHeight), cmp.Compare(b.TxPos, a.TxPos))
+	})
+
+	return page(transfers, filter.Limit, filter.Offset), nil
+}
+
+func (m *memory) DeleteIbcTransfers(ctx context.Context, txHash []byte) error {
+	defer m.lock()()
+
+	m.data.ibcTransfers = slices.DeleteFunc(m.data.ibcTransfers, func(t repository.IbcTransfer) bool {
+		return bytes.Equal(t.TxHash, txHash)
+	})
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddMaspTxs(ctx context.Context, txs ...repository.MaspTx) error {
+	defer m.lock()()
+
+	m.data.maspTxs = append(m.data.maspTxs, txs...)
+
+	return nil
+}
+
+func (m *memory) GetMaspTxs(ctx context.Context, txHash []byte) ([]repository.MaspTx, error) {
+	defer m.rlock()()
+
+	var txs []repository.MaspTx
+	for _, t := range m.data.maspTxs {
+		if bytes.Equal(t.TxHash, txHash) {
+			txs = append(txs, t)
+		}
+	}
+	slices.SortStableFunc(txs, func(a, b repository.MaspTx) int {
+		return cmp.Compare(a.MaspPos, b.MaspPos)
+	})
+
+	return txs, nil
+}
+
+func (m *memory) DeleteMaspTxs(ctx context.Context, txHash []byte) error {
+	defer m.lock()()
+
+	m.data.maspTxs = slices.DeleteFunc(m.data.maspTxs, func(t repository.MaspTx) bool {
+		return bytes.Equal(t.TxHash, txHash)
+	})
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package memory
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddMaspAssets(ctx context.Context, assets ...repository.MaspAsset) error {
+	defer m.lock()()
+
+	for _, asset := range assets {
+		if _, ok := m.data.maspAssets[string(asset.AssetType)]; !ok {
+			m.data.maspAssets[string(asset.AssetType)] = asset
+		}
+	}
+
+	return nil
+}
+
+func (m *memory) GetMaspAssets(ctx context.Context, assetTypes ...[]byte) ([]repository.MaspAsset, error) {
+	defer m.rlock()()
+
+	var assets []repository.MaspAsset
+	if len(assetTypes) == 0 {
+		for _, asset := range m.data.maspAssets {
+			assets = append(assets, asset)
+		}
+	} else {
+		for _, assetType := range assetTypes {
+			if asset, ok := m.data.maspAssets[string(assetType)]; ok && !slices.ContainsFunc(assets, func(a repository.MaspAsset) bool {
+				return bytes.Equal(a.AssetType, assetType)
+			}) {
+				assets = append(assets, asset)
+			}
+		}
+	}
+	slices.SortFunc(assets, func(a, b repository.MaspAsset) int {
+		return bytes.Compare(a.AssetType, b.AssetType)
+	})
+
+	return assets, nil
+}
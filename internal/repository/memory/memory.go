@@ -0,0 +1,207 @@
+package memory
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"math/big"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// memory keeps all rows in process memory. It follows the semantics of the SQL backends and is meant
+// for tests and local runs without a database.
+type memory struct {
+	// mu is nil inside of transaction, the transaction holds the lock of its repository
+	mu   *sync.RWMutex
+	data *data
+}
+
+type data struct {
+	blocks              []repository.Block
+	transactions        []repository.Transaction
+	accountTransactions []repository.AccountTransaction
+	commitSignatures    []repository.CommitSignature
+	evidences           []repository.Evidence
+	rawTxs              []repository.RawTx
+	failedTxs           []repository.FailedTx
+	blockEvents         []repository.BlockEvent
+	ibcTransfers        []repository.IbcTransfer
+	maspTxs             []repository.MaspTx
+	maspAssets          map[string]repository.MaspAsset
+	indexerStatus       *repository.IndexerStatus
+	prunedAccountTotals map[string]uint64
+	prunedShielded      map[string]string
+	prunedFees          []prunedFees
+}
+
+// prunedFees are fee stats of pruned wrapper txs by day, fee payer and fee token.
+type prunedFees struct {
+	repository.FeeStats
+	feePayer string
+}
+
+func NewRepository() *memory {
+	return &memory{
+		mu: &sync.RWMutex{},
+		data: &data{
+			maspAssets:          make(map[string]repository.MaspAsset),
+			prunedAccountTotals: make(map[string]uint64),
+			prunedShielded:      make(map[string]string),
+		},
+	}
+}
+
+func (d *data) clone() *data {
+	clone := *d
+	clone.blocks = slices.Clone(d.blocks)
+	clone.transactions = slices.Clone(d.transactions)
+	clone.accountTransactions = slices.Clone(d.accountTransactions)
+	clone.commitSignatures = slices.Clone(d.commitSignatures)
+	clone.evidences = slices.Clone(d.evidences)
+	clone.rawTxs = slices.Clone(d.rawTxs)
+	clone.failedTxs = slices.Clone(d.failedTxs)
+	clone.blockEvents = slices.Clone(d.blockEvents)
+	clone.ibcTransfers = slices.Clone(d.ibcTransfers)
+	clone.maspTxs = slices.Clone(d.maspTxs)
+	clone.maspAssets = maps.Clone(d.maspAssets)
+	clone.prunedAccountTotals = maps.Clone(d.prunedAccountTotals)
+	clone.prunedShielded = maps.Clone(d.prunedShielded)
+	clone.prunedFees = slices.Clone(d.prunedFees)
+	return &clone
+}
+
+// lock takes the write lock unless the repository runs inside of transaction and returns the unlock function.
+func (m *memory) lock() func() {
+	if m.mu == nil {
+		return func() {}
+	}
+	m.mu.Lock()
+	return m.mu.Unlock
+}
+
+// rlock is lock for reading.
+func (m *memory) rlock() func() {
+	if m.mu == nil {
+		return func() {}
+	}
+	m.mu.RLock()
+	return m.mu.RUnlock
+}
+
+func (m *memory) Close() error {
+	return nil
+}
+
+// RunInTransaction runs txFunc on a copy of the data which replaces the data if txFunc succeeds.
+// Transactions are serialized with all other writes.
+func (m *memory) RunInTransaction(ctx context.Context, txFunc repository.InTransaction) (err error) {
+	if m.mu == nil {
+		return errors.Create("Can't run transaction inside of another transaction")
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	runner := &memory{nil, m.data.clone()}
+	if err = txFunc(ctx, runner); err != nil {
+		return err
+	}
+
+	m.data = runner.data
+
+	return nil
+}
+
+// EnsurePartitions does nothing, there are no partitions in memory.
+func (m *memory) EnsurePartitions(context.Context, int64) error {
+	return nil
+}
+
+func (m *memory) Ping(context.Context) error {
+	return nil
+}
+
+// GetReplicasStatus returns no replicas, there is only one copy of the data.
+func (m *memory) GetReplicasStatus(context.Context) ([]repository.ReplicaStatus, error) {
+	return nil, nil
+}
+
+// page returns the part of rows selected by limit and offset, 0 limit means no limit.
+func page[T any](rows []T, limit, offset uint64) []T {
+	if offset >= uint64(len(rows)) {
+		return nil
+	}
+	rows = rows[offset:]
+	if limit != 0 && limit < uint64(len(rows)) {
+		rows = rows[:limit]
+	}
+	return rows
+}
+
+// jsonText returns the value of the key of JSON object as text like ->> operator does,
+// false if there is no such key or the value is null.
+func jsonText(data []byte, key string) (string, bool) {
+	if len(data) == 0 {
+		return "", false
+	}
+
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil {
+		return "", false
+	}
+
+	return rawText(object[key])
+}
+
+func rawText(value json.RawMessage) (string, bool) {
+	if len(value) == 0 || string(value) == "null" {
+		return "", false
+	}
+
+	var s string
+	if err := json.Unmarshal(value, &s); err == nil {
+		return s, true
+	}
+
+	return string(value), true
+}
+
+func containsBytes(values [][]byte, value []byte) bool {
+	return slices.ContainsFunc(values, func(v []byte) bool { return string(v) == string(value) })
+}
+
+// isDecimal checks that s is digits with an optional fractional part.
+func isDecimal(s string) bool {
+	integer, fraction, hasFraction := strings.Cut(s, ".")
+	return isDigits(integer) && (!hasFraction || isDigits(fraction))
+}
+
+func isDigits(s string) bool {
+	return s != "" && strings.Trim(s, "0123456789") == ""
+}
+
+// addDecimals sums decimal numbers keeping the largest scale of them like numeric type does,
+// empty values are skipped.
+func addDecimals(values ...string) (string, error) {
+	sum, scale := new(big.Rat), 0
+	for _, value := range values {
+		if value == "" {
+			continue
+		}
+		v, ok := new(big.Rat).SetString(value)
+		if !ok {
+			return "", errors.Create("Invalid decimal " + value)
+		}
+		sum.Add(sum, v)
+
+		if _, fraction, ok := strings.Cut(value, "."); ok {
+			scale = max(scale, len(fraction))
+		}
+	}
+	return sum.FloatString(scale), nil
+}
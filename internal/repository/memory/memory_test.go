@@ -0,0 +1,14 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/repository/repotest"
+)
+
+func TestRepository(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repository.Repository {
+		return NewRepository()
+	})
+}
@@ -0,0 +1,111 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// PruneBlocks deletes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+// Account txs counts, shielded amounts and fees of deleted txs are added to pruned aggregates before.
+func (m *memory) PruneBlocks(ctx context.Context, fromHeight, toHeight int64, maspAddress string) error {
+	defer m.lock()()
+
+	// Aggregates are calculated on a copy, so nothing changes if an amount can't be added
+	// A tx is counted once per address if the address has several roles in it
+	accountTotals := make(map[string]uint64)
+	counted := make(map[[2]string]bool)
+	for _, tx := range m.data.accountTransactions {
+		key := [2]string{tx.Address, string(tx.TxHash)}
+		if tx.BlockHeight >= fromHeight && tx.BlockHeight <= toHeight && !counted[key] {
+			accountTotals[tx.Address]++
+			counted[key] = true
+		}
+	}
+
+	// Same as shielded amounts calculated from transfers by the service, amounts which are not numbers are skipped
+	shielded := make(map[string]string)
+	for _, tx := range m.data.transactions {
+		if tx.BlockHeight < fromHeight || tx.BlockHeight > toHeight || tx.TxType != "Decrypted" {
+			continue
+		}
+
+		source, _ := jsonText(tx.Data, "source")
+		target, _ := jsonText(tx.Data, "target")
+		amount, _ := jsonText(tx.Data, "amount")
+		if (source == maspAddress) == (target == maspAddress) || !isDecimal(amount) {
+			continue
+		}
+		if source == maspAddress {
+			amount = "-" + amount
+		}
+
+		token, _ := jsonText(tx.Data, "token")
+		sum, err := addDecimals(shielded[token], amount)
+		if err != nil {
+			return errors.New(err, "Sum shielded amounts")
+		}
+		shielded[token] = sum
+	}
+
+	// Same as fee stats calculated by GetFeeStats, grouped by day to keep stats by day
+	fees := slices.Clone(m.data.prunedFees)
+	for _, w := range m.data.transactions {
+		if w.BlockHeight < fromHeight || w.BlockHeight > toHeight || w.FeePaid == nil {
+			continue
+		}
+		block, ok := m.block(w.BlockID)
+		if !ok {
+			continue
+		}
+		day := block.HeaderTime.UTC().Truncate(24 * time.Hour)
+
+		i := slices.IndexFunc(fees, func(f prunedFees) bool {
+			return f.Day.Equal(day) && f.feePayer == w.FeePayer && f.FeeToken == w.FeeToken
+		})
+		if i < 0 {
+			fees = append(fees, prunedFees{FeeStats: repository.FeeStats{Day: day, FeeToken: w.FeeToken, FeePaid: "0"}, feePayer: w.FeePayer})
+			i = len(fees) - 1
+		}
+
+		stats, feesPaid := m.wrapperFees(w)
+		feePaid, err := addDecimals(append(feesPaid, fees[i].FeePaid)...)
+		if err != nil {
+			return errors.New(err, "Sum fees paid")
+		}
+		fees[i].TxsCount += stats.TxsCount
+		fees[i].GasLimit += stats.GasLimit
+		fees[i].GasUsed += stats.GasUsed
+		fees[i].FeePaid = feePaid
+	}
+
+	m.data.prunedFees = fees
+	for address, cnt := range accountTotals {
+		m.data.prunedAccountTotals[address] += cnt
+	}
+	for token, amount := range shielded {
+		sum, err := addDecimals(m.data.prunedShielded[token], amount)
+		if err != nil {
+			return errors.New(err, "Sum shielded amounts")
+		}
+		m.data.prunedShielded[token] = sum
+	}
+
+	m.deleteBlocks(fromHeight, toHeight)
+
+	return nil
+}
+
+func (m *memory) GetPrunedShielded(ctx context.Context) ([]repository.TokenAmount, error) {
+	defer m.rlock()()
+
+	var amounts []repository.TokenAmount
+	for token, amount := range m.data.prunedShielded {
+		amounts = append(amounts, repository.TokenAmount{Token: token, Amount: amount})
+	}
+
+	return amounts, nil
+}
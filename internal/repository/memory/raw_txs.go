@@ -0,0 +1,33 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) AddRawTxs(ctx context.Context, txs ...repository.RawTx) error {
+	defer m.lock()()
+
+	m.data.rawTxs = append(m.data.rawTxs, txs...)
+
+	return nil
+}
+
+func (m *memory) GetRawTxs(ctx context.Context, fromHeight, toHeight int64) ([]repository.RawTx, error) {
+	defer m.rlock()()
+
+	var txs []repository.RawTx
+	for _, tx := range m.data.rawTxs {
+		if tx.BlockHeight >= fromHeight && tx.BlockHeight <= toHeight {
+			txs = append(txs, tx)
+		}
+	}
+	slices.SortStableFunc(txs, func(a, b repository.RawTx) int {
+		return cmp.Or(cmp.Compare(a.BlockHeight, b.BlockHeight), cmp.Compare(a.TxPos, b.TxPos))
+	})
+
+	return txs, nil
+}
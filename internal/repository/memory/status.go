@@ -0,0 +1,25 @@
+package memory
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (m *memory) SaveIndexerStatus(ctx context.Context, status repository.IndexerStatus) error {
+	defer m.lock()()
+
+	m.data.indexerStatus = &status
+
+	return nil
+}
+
+func (m *memory) GetIndexerStatus(ctx context.Context) (repository.IndexerStatus, error) {
+	defer m.rlock()()
+
+	if m.data.indexerStatus == nil {
+		return repository.IndexerStatus{}, repository.ErrNotFound
+	}
+
+	return *m.data.indexerStatus, nil
+}
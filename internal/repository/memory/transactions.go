@@ -0,0 +1,191 @@
+package memory
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"slices"
+	"strconv"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (m *memory) AddTransactions(ctx context.Context, txs ...repository.Transaction) error {
+	defer m.lock()()
+
+	for _, tx := range txs {
+		if _, ok := m.block(tx.BlockID); !ok {
+			return errors.Create("Block of transaction doesn't exist")
+		}
+		if len(tx.Data) != 0 && !json.Valid(tx.Data) {
+			return errors.Create("Transaction data is not valid JSON")
+		}
+	}
+
+	m.data.transactions = append(m.data.transactions, txs...)
+
+	return nil
+}
+
+func matchTx(tx repository.Transaction, filter repository.TxFilter) bool {
+	if len(filter.Hashes) != 0 && !containsBytes(filter.Hashes, tx.Hash) {
+		return false
+	}
+	if len(filter.BlockID) != 0 && !bytes.Equal(tx.BlockID, filter.BlockID) {
+		return false
+	}
+	if filter.Height != 0 && tx.BlockHeight != filter.Height {
+		return false
+	}
+	if filter.Memo != "" && (tx.Memo == nil || *tx.Memo != filter.Memo) {
+		return false
+	}
+	if filter.TxType != "" && tx.TxType != filter.TxType {
+		return false
+	}
+	return true
+}
+
+func (m *memory) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter) (uint64, error) {
+	defer m.rlock()()
+
+	var total uint64
+	for _, tx := range m.data.transactions {
+		if matchTx(tx, filter) {
+			total++
+		}
+	}
+
+	return total, nil
+}
+
+func (m *memory) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]repository.Transaction, error) {
+	defer m.rlock()()
+
+	var txs []repository.Transaction
+	for _, tx := range m.data.transactions {
+		if !matchTx(tx, filter) {
+			continue
+		}
+		block, ok := m.block(tx.BlockID)
+		if !ok {
+			continue
+		}
+		tx.BlockHeight, tx.BlockTime = block.HeaderHeight, block.HeaderTime
+		txs = append(txs, tx)
+	}
+
+	sortLatestTxs(txs)
+
+	return page(txs, filter.Limit, filter.Offset), nil
+}
+
+// sortLatestTxs orders txs by height and position in block descending.
+func sortLatestTxs(txs []repository.Transaction) {
+	slices.SortStableFunc(txs, func(a, b repository.Transaction) int {
+		return cmp.Or(cmp.Compare(b.BlockHeight, a.BlockHeight), cmp.Compare(b.PosInBlock, a.PosInBlock))
+	})
+}
+
+func (m *memory) GetTxsBySourceOrTarget(ctx context.Context, address string) ([]repository.Transaction, error) {
+	defer m.rlock()()
+
+	var txs []repository.Transaction
+	for _, tx := range m.data.transactions {
+		if tx.TxType != "Decrypted" {
+			continue
+		}
+		source, _ := jsonText(tx.Data, "source")
+		target, _ := jsonText(tx.Data, "target")
+		if (source == address || target == address) && m.hasSourceOrTarget(address, tx) {
+			txs = append(txs, tx)
+		}
+	}
+
+	return txs, nil
+}
+
+// hasSourceOrTarget reports whether the address has an account transaction with source or target role in the tx.
+func (m *memory) hasSourceOrTarget(address string, tx repository.Transaction) bool {
+	return slices.ContainsFunc(m.data.accountTransactions, func(accTx repository.AccountTransaction) bool {
+		return accTx.Address == address && accTx.BlockHeight == tx.BlockHeight && bytes.Equal(accTx.TxHash, tx.Hash) &&
+			(accTx.Role == repository.RoleSource || accTx.Role == repository.RoleTarget)
+	})
+}
+
+func (m *memory) GetVoteProposalDatas(ctx context.Context, voteCodes [][]byte, proposalID int64) ([]json.RawMessage, error) {
+	defer m.rlock()()
+
+	var txs []repository.Transaction
+	for _, tx := range m.data.transactions {
+		if !containsBytes(voteCodes, tx.Code) {
+			continue
+		}
+		id, ok := jsonText(tx.Data, "id")
+		if !ok {
+			continue
+		}
+		parsed, err := strconv.ParseInt(id, 10, 32)
+		if err != nil {
+			return nil, errors.New(err, "Parse proposal id")
+		}
+		if parsed == proposalID {
+			txs = append(txs, tx)
+		}
+	}
+
+	sortLatestTxs(txs)
+
+	var datas []json.RawMessage
+	for _, tx := range txs {
+		datas = append(datas, json.RawMessage(tx.Data))
+	}
+
+	return datas, nil
+}
+
+func (m *memory) GetHeightsWithoutWrapperIDs(ctx context.Context) ([]int64, error) {
+	defer m.rlock()()
+
+	var heights []int64
+	for _, tx := range m.data.transactions {
+		if tx.TxType == "Decrypted" && tx.WrapperID == nil {
+			heights = append(heights, tx.BlockHeight)
+		}
+	}
+	slices.Sort(heights)
+
+	return slices.Compact(heights), nil
+}
+
+// updateTx applies update to the tx with the hash at the height.
+func (m *memory) updateTx(blockHeight int64, txHash []byte, update func(tx *repository.Transaction)) {
+	for i := range m.data.transactions {
+		tx := &m.data.transactions[i]
+		if tx.BlockHeight == blockHeight && bytes.Equal(tx.Hash, txHash) {
+			update(tx)
+		}
+	}
+}
+
+func (m *memory) UpdateWrapperID(ctx context.Context, blockHeight int64, txHash, wrapperID []byte) error {
+	defer m.lock()()
+
+	m.updateTx(blockHeight, txHash, func(tx *repository.Transaction) { tx.WrapperID = wrapperID })
+
+	return nil
+}
+
+func (m *memory) UpdateTxData(ctx context.Context, blockHeight int64, txHash []byte, data []byte) error {
+	defer m.lock()()
+
+	if len(data) != 0 && !json.Valid(data) {
+		return errors.Create("Transaction data is not valid JSON")
+	}
+
+	m.updateTx(blockHeight, txHash, func(tx *repository.Transaction) { tx.Data = data })
+
+	return nil
+}
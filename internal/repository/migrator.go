@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// Migrator applies versioned schema migrations embedded in the binary.
+type Migrator interface {
+	// MigrateUp applies all pending migrations and returns how many were applied.
+	MigrateUp(ctx context.Context) (int, error)
+	// MigrateDown reverts the last steps applied migrations.
+	MigrateDown(ctx context.Context, steps int) error
+	MigrationStatus(ctx context.Context) ([]Migration, error)
+}
+
+type Migration struct {
+	Version   int64
+	Name      string
+	AppliedAt *time.Time
+}
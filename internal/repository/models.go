@@ -28,6 +28,37 @@ type Block struct {
 	CommitBlockIDPartsHeaderHash      []byte
 }
 
+const (
+	SyncStateStarting = "starting"
+	SyncStateSyncing  = "syncing"
+	SyncStateSynced   = "synced"
+	SyncStateRollback = "rollback"
+	SyncStateStopped  = "stopped"
+	SyncStateFailed   = "failed"
+)
+
+// IndexerStatusInterval is the interval at which the running indexer saves its status.
+const IndexerStatusInterval = 5 * time.Second
+
+type IndexerStatus struct {
+	IndexedHeight int64
+	ChainHeight   int64
+	LastBlockTime *time.Time
+	SyncState     string
+	Version       string
+	Checksums     string
+	ErrorCount    int64
+	UpdatedAt     time.Time
+}
+
+type ReplicaStatus struct {
+	Name string
+	// Available is false if the replica is unreachable or lags more than allowed, then it isn't queried
+	Available  bool
+	Lag        time.Duration
+	LastHeight int64
+}
+
 type BlockFilter struct {
 	Height  int64
 	BlockID []byte
@@ -42,28 +73,199 @@ type Transaction struct {
 	FeeAmountPerGasUnit string
 	FeeToken            string
 	GasLimitMultiplier  *uint64
-	Code                []byte
-	Data                []byte
-	ReturnCode          *int64
-	PosInBlock          int64
-	BlockHeight         int64
-	BlockTime           time.Time
+	GasUsed             *uint64
+	FeePaid             *string
+	// FeePayer is the implicit address of the wrapper public key, it's empty for decrypted txs
+	FeePayer    string
+	Code        []byte
+	Data        []byte
+	ReturnCode  *int64
+	PosInBlock  int64
+	BlockHeight int64
+	BlockTime   time.Time
 }
 
 type TxFilter struct {
 	Hashes  [][]byte
 	BlockID []byte
+	Height  int64
 	Memo    string
 	TxType  string
 	Offset  uint64
 	Limit   uint64
 }
 
+const (
+	FeeGroupByBlock = "block"
+	FeeGroupByDay   = "day"
+	FeeGroupByToken = "token"
+)
+
+type FeeStatsFilter struct {
+	GroupBy    string
+	FeeToken   string
+	FeePayer   string
+	FromHeight int64
+	ToHeight   int64
+	Offset     uint64
+	Limit      uint64
+}
+
+type FeeStats struct {
+	Height   int64
+	Day      time.Time
+	FeeToken string
+	TxsCount uint64
+	GasLimit uint64
+	GasUsed  uint64
+	FeePaid  string
+}
+
+type TokenAmount struct {
+	Token  string
+	Amount string
+}
+
+// Roles of addresses in account transactions.
+const (
+	RoleSource        = "source"
+	RoleTarget        = "target"
+	RoleValidator     = "validator"
+	RoleSrcValidator  = "src_validator"
+	RoleDestValidator = "dest_validator"
+	RoleVoter         = "voter"
+	RoleAuthor        = "author"
+	RoleSteward       = "steward"
+	RoleFeePayer      = "fee_payer"
+)
+
+// AccountRoles lists all roles of addresses in account transactions.
+var AccountRoles = []string{
+	RoleSource, RoleTarget, RoleValidator, RoleSrcValidator, RoleDestValidator, RoleVoter, RoleAuthor, RoleSteward, RoleFeePayer,
+}
+
+// AccountTransaction links a tx to an address involved in it, a tx has one row per address and role.
 type AccountTransaction struct {
 	Address     string
 	TxHash      []byte
 	BlockHeight int64
 	TxPos       int64
+	Role        string
+}
+
+// IbcTransfer is a token transfer made by an IBC message, destination and sequence are empty for sent transfers.
+type IbcTransfer struct {
+	TxHash             []byte
+	BlockHeight        int64
+	TxPos              int64
+	MsgType            string
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	Sequence           int64
+	Denom              string
+	Amount             string
+	Sender             string
+	Receiver           string
+	Memo               string
+}
+
+// IbcTransferFilter matches transfers with the channel as source or destination channel.
+type IbcTransferFilter struct {
+	Channel string
+	Denom   string
+	Offset  uint64
+	Limit   uint64
+}
+
+// MaspTx is a MASP transaction of a tx, MaspPos orders MASP transactions of the same tx.
+// Asset types and transparent addresses are hex encoded.
+type MaspTx struct {
+	TxHash             []byte
+	BlockHeight        int64
+	TxPos              int64
+	MaspPos            int64
+	ExpiryHeight       int64
+	SpendsCount        int64
+	ConvertsCount      int64
+	OutputsCount       int64
+	ValueBalance       []MaspAssetValue
+	TransparentInputs  []MaspTransparentValue
+	TransparentOutputs []MaspTransparentValue
+}
+
+type MaspAssetValue struct {
+	AssetType string `json:"asset_type"`
+	Value     string `json:"value"`
+}
+
+type MaspTransparentValue struct {
+	AssetType string `json:"asset_type"`
+	Value     uint64 `json:"value"`
+	Address   string `json:"address"`
+}
+
+// MaspAsset is the asset data a MASP asset type is derived from. Asset types don't depend on blocks,
+// they are kept when blocks are deleted.
+type MaspAsset struct {
+	AssetType []byte
+	Token     string
+	Denom     int64
+	Position  int64
+	Epoch     *int64
+}
+
+type RawTx struct {
+	TxHash      []byte
+	BlockID     []byte
+	BlockHeight int64
+	TxPos       int64
+	Data        []byte
+}
+
+type FailedTx struct {
+	BlockID     []byte
+	BlockHeight int64
+	TxPos       int64
+	TxHash      []byte
+	// TxType is inferred from the position of the tx in the block if the tx can't be decoded
+	TxType    string
+	Data      []byte
+	Error     string
+	CreatedAt time.Time
+}
+
+const (
+	EventKindBeginBlock = "begin_block"
+	EventKindEndBlock   = "end_block"
+	EventKindTx         = "tx"
+)
+
+type BlockEvent struct {
+	BlockID     []byte
+	BlockHeight int64
+	Kind        string
+	EventPos    int64
+	Type        string
+	TxHash      []byte
+	Attributes  []EventAttribute
+}
+
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type EventFilter struct {
+	Type       string
+	Key        string
+	Value      string
+	TxHash     []byte
+	FromHeight int64
+	ToHeight   int64
+	Offset     uint64
+	Limit      uint64
 }
 
 type Evidence struct {
@@ -77,6 +279,7 @@ type Evidence struct {
 
 type CommitSignature struct {
 	BlockID          []byte
+	BlockHeight      int64
 	BlockIDFlag      int
 	ValidatorAddress []byte
 	Timestamp        int64
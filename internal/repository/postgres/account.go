@@ -10,37 +10,32 @@ import (
 )
 
 func (p *postgres) AddAccountTransactions(ctx context.Context, txs ...repository.AccountTransaction) error {
-	if len(txs) == 0 {
-		return nil
+	rows := make([][]any, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []any{tx.Address, tx.TxHash, tx.BlockHeight, tx.TxPos, tx.Role})
 	}
 
-	builder := p.psql.Insert(accountTransactionsTable).
-		Columns("address", "tx_hash", "block_height", "tx_pos")
+	return p.insertRows(ctx, "AddAccountTransactions", accountTransactionsTable, []string{"address", "tx_hash", "block_height", "tx_pos", "role"}, rows)
+}
 
-	for _, tx := range txs {
-		builder = builder.Values(tx.Address, tx.TxHash, tx.BlockHeight, tx.TxPos)
-	}
+// GetTotalAccountTxs counts txs of the address, a tx is counted once if the address has several roles in it.
+// Txs of pruned blocks are counted only if role is empty, pruned totals are not split by roles.
+func (p *postgres) GetTotalAccountTxs(ctx context.Context, address []byte, role string) (uint64, error) {
+	builder := p.psql.Select().From(accountTransactionsTable).Where(sq.Eq{"address": address})
 
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return errors.New(err, "Build SQL for AddAccountTransactions")
+	if role == "" {
+		builder = builder.Column(sq.Expr("COUNT(DISTINCT tx_hash) + COALESCE((SELECT txs_count FROM "+prunedAccountTotalsTable+" WHERE address = ?), 0)", address))
+	} else {
+		builder = builder.Column("COUNT(DISTINCT tx_hash)").Where(sq.Eq{"role": role})
 	}
 
-	_, err = p.exec.ExecContext(ctx, query, args...)
-	return errors.New(err, "Exec SQL for AddAccountTransactions")
-}
-
-func (p *postgres) GetTotalAccountTxs(ctx context.Context, address []byte) (uint64, error) {
-	query, args, err := p.psql.Select("COUNT(*)").
-		From(accountTransactionsTable).
-		Where(sq.Eq{"address": address}).
-		ToSql()
+	query, args, err := builder.ToSql()
 	if err != nil {
 		return 0, errors.New(err, "Build SQL for GetTotalAccountTxs")
 	}
 
 	var total uint64
-	err = p.exec.QueryRowContext(ctx, query, args...).Scan(&total)
+	err = p.reader().QueryRowContext(ctx, query, args...).Scan(&total)
 	if err != nil {
 		return 0, errors.New(err, "Exec SQL for GetTotalAccountTxs")
 	}
@@ -48,8 +43,17 @@ func (p *postgres) GetTotalAccountTxs(ctx context.Context, address []byte) (uint
 	return total, nil
 }
 
-func (p *postgres) GetAccountTxs(ctx context.Context, address []byte, limit, offset uint64) ([][]byte, error) {
-	builder := p.psql.Select("tx_hash").From(accountTransactionsTable).Where(sq.Eq{"address": address}).OrderBy("block_height DESC", "tx_pos DESC")
+// GetAccountTxs returns hashes of txs of the address with the role or with any role if it's empty, latest first.
+func (p *postgres) GetAccountTxs(ctx context.Context, address []byte, role string, limit, offset uint64) ([][]byte, error) {
+	builder := p.psql.Select("tx_hash").
+		From(accountTransactionsTable).
+		Where(sq.Eq{"address": address}).
+		GroupBy("tx_hash", "block_height", "tx_pos").
+		OrderBy("block_height DESC", "tx_pos DESC")
+
+	if role != "" {
+		builder = builder.Where(sq.Eq{"role": role})
+	}
 
 	if limit != 0 {
 		builder = builder.Limit(limit)
@@ -61,7 +65,7 @@ func (p *postgres) GetAccountTxs(ctx context.Context, address []byte, limit, off
 		return nil, errors.New(err, "Build SQL for GetAccountTxs")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetAccountTxs")
 	}
@@ -79,17 +83,27 @@ func (p *postgres) GetAccountTxs(ctx context.Context, address []byte, limit, off
 	return txHashes, nil
 }
 
-func (p *postgres) GetAccountThresholds(ctx context.Context, updateAccountCode []byte, accountID string) ([]*uint8, error) {
+func (p *postgres) DeleteAccountTransactions(ctx context.Context, txHash []byte) error {
+	query, args, err := p.psql.Delete(accountTransactionsTable).Where(sq.Eq{"tx_hash": txHash}).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteAccountTransactions")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteAccountTransactions")
+}
+
+func (p *postgres) GetAccountThresholds(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*uint8, error) {
 	query, args, err := p.psql.Select("data->>'threshold'").
 		From(transactionsTable).
-		Where(sq.Eq{"code": updateAccountCode}).
+		Where(sq.Eq{"code": updateAccountCodes}).
 		Where(sq.Eq{"data->>'address'": accountID}).
 		ToSql()
 	if err != nil {
 		return nil, errors.New(err, "Build SQL for GetAccountThresholds")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetAccountThresholds")
 	}
@@ -108,17 +122,17 @@ func (p *postgres) GetAccountThresholds(ctx context.Context, updateAccountCode [
 	return thresholds, nil
 }
 
-func (p *postgres) GetAccountVPCodes(ctx context.Context, updateAccountCode []byte, accountID string) ([]*string, error) {
+func (p *postgres) GetAccountVPCodes(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*string, error) {
 	query, args, err := p.psql.Select("data->>'vp_code_hash'").
 		From(transactionsTable).
-		Where(sq.Eq{"code": updateAccountCode}).
+		Where(sq.Eq{"code": updateAccountCodes}).
 		Where(sq.Eq{"data->>'address'": accountID}).
 		ToSql()
 	if err != nil {
 		return nil, errors.New(err, "Build SQL for GetAccountVPCodes")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetAccountVPCodes")
 	}
@@ -137,17 +151,17 @@ func (p *postgres) GetAccountVPCodes(ctx context.Context, updateAccountCode []by
 	return vpCodes, nil
 }
 
-func (p *postgres) GetAccountPublicKeys(ctx context.Context, updateAccountCode []byte, accountID string) ([][]string, error) {
+func (p *postgres) GetAccountPublicKeys(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([][]string, error) {
 	query, args, err := p.psql.Select("ARRAY(SELECT jsonb_array_elements_text(data->'public_keys'))").
 		From(transactionsTable).
-		Where(sq.Eq{"code": updateAccountCode}).
+		Where(sq.Eq{"code": updateAccountCodes}).
 		Where(sq.Eq{"data->>'address'": accountID}).
 		ToSql()
 	if err != nil {
 		return nil, errors.New(err, "Build SQL for GetAccountPublicKeys")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetAccountPublicKeys")
 	}
@@ -66,7 +66,7 @@ func (p *postgres) GetBlockBy(ctx context.Context, filter repository.BlockFilter
 	}
 
 	var block repository.Block
-	err = p.exec.QueryRowContext(ctx, query, args...).Scan(&block.BlockID, &block.HeaderVersionApp, &block.HeaderVersionBlock, &block.HeaderChainID, &block.HeaderHeight, &block.HeaderTime,
+	err = p.reader().QueryRowContext(ctx, query, args...).Scan(&block.BlockID, &block.HeaderVersionApp, &block.HeaderVersionBlock, &block.HeaderChainID, &block.HeaderHeight, &block.HeaderTime,
 		&block.HeaderLastBlockIDHash, &block.HeaderLastBlockIDPartsHeaderTotal, &block.HeaderLastBlockIDPartsHeaderHash,
 		&block.HeaderLastCommitHash, &block.HeaderDataHash, &block.HeaderValidatorsHash, &block.HeaderNextValidatorsHash, &block.HeaderConsensusHash, &block.HeaderAppHash,
 		&block.HeaderLastResultsHash, &block.HeaderEvidenceHash, &block.HeaderProposerAddress,
@@ -96,7 +96,7 @@ func (p *postgres) GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*
 		return nil, errors.New(err, "Build SQL for GetLatestBlocks")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetLatestBlocks")
 	}
@@ -117,3 +117,46 @@ func (p *postgres) GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*
 
 	return blocks, nil
 }
+
+// DeleteBlocks removes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+func (p *postgres) DeleteBlocks(ctx context.Context, fromHeight, toHeight int64) error {
+	var (
+		query string
+		args  []any
+		err   error
+	)
+
+	for _, table := range []string{transactionsTable, commitSignaturesTable, accountTransactionsTable, rawTxsTable, failedTxsTable, blockEventsTable, ibcTransfersTable, maspTxsTable} {
+		query, args, err = p.psql.Delete(table).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			ToSql()
+		if err != nil {
+			return errors.New(err, "Build SQL for DeleteBlocks "+table)
+		}
+		if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+			return errors.New(err, "Exec SQL for DeleteBlocks "+table)
+		}
+	}
+
+	blockIDs := sq.Expr("block_id IN (SELECT block_id FROM "+blocksTable+" WHERE header_height >= ? AND header_height <= ?)", fromHeight, toHeight)
+
+	query, args, err = p.psql.Delete(evidencesTable).Where(blockIDs).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteBlocks "+evidencesTable)
+	}
+	if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for DeleteBlocks "+evidencesTable)
+	}
+
+	query, args, err = p.psql.Delete(blocksTable).
+		Where(sq.GtOrEq{"header_height": fromHeight}).
+		Where(sq.LtOrEq{"header_height": toHeight}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteBlocks")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteBlocks")
+}
@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lib/pq"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+const (
+	// maxQueryParams is the limit of bind parameters in a single Postgres statement.
+	maxQueryParams = 65535
+	// copyMinRows is the number of rows starting from which COPY is used instead of INSERT.
+	// COPY needs a database transaction, so it is used only inside RunInTransaction.
+	copyMinRows = 256
+)
+
+// insertRows saves rows to the table with COPY if there are enough of them and the repository runs
+// inside of transaction, otherwise with multi-VALUES INSERT statements split to fit into parameters limit.
+// name is used in error messages.
+func (p *postgres) insertRows(ctx context.Context, name, table string, columns []string, rows [][]any) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// Typed nil slices are not NULL for the driver
+	for _, row := range rows {
+		for i, value := range row {
+			if b, ok := value.([]byte); ok && b == nil {
+				row[i] = nil
+			}
+		}
+	}
+
+	if p.db == nil && len(rows) >= copyMinRows {
+		return p.copyRows(ctx, name, table, columns, rows)
+	}
+
+	chunkSize := maxQueryParams / len(columns)
+	for start := 0; start < len(rows); start += chunkSize {
+		builder := p.psql.Insert(table).Columns(columns...)
+		for _, row := range rows[start:min(start+chunkSize, len(rows))] {
+			builder = builder.Values(row...)
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return errors.New(err, "Build SQL for "+name)
+		}
+
+		if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+			return errors.New(err, "Exec SQL for "+name)
+		}
+	}
+
+	return nil
+}
+
+func (p *postgres) copyRows(ctx context.Context, name, table string, columns []string, rows [][]any) (err error) {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = pq.QuoteIdentifier(column)
+	}
+
+	stmt, err := p.exec.PrepareContext(ctx, fmt.Sprintf("COPY %s (%s) FROM STDIN", table, strings.Join(quoted, ", ")))
+	if err != nil {
+		return errors.New(err, "Prepare COPY for "+name)
+	}
+	defer func() {
+		if closeErr := stmt.Close(); err == nil {
+			err = errors.New(closeErr, "Close COPY for "+name)
+		}
+	}()
+
+	for _, row := range rows {
+		if _, err = stmt.ExecContext(ctx, row...); err != nil {
+			return errors.New(err, "Exec COPY for "+name)
+		}
+	}
+
+	_, err = stmt.ExecContext(ctx)
+	return errors.New(err, "Flush COPY for "+name)
+}
+
+// jsonValue passes JSON as text, COPY would encode bytes as bytea.
+func jsonValue(data []byte) any {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
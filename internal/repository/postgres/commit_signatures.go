@@ -10,40 +10,27 @@ import (
 )
 
 func (p *postgres) AddCommitSignatures(ctx context.Context, signatures ...repository.CommitSignature) error {
-	if len(signatures) == 0 {
-		return nil
-	}
-
-	builder := p.psql.Insert(commitSignaturesTable).
-		Columns("block_id", "block_id_flag", "validator_address", "timestamp", "signature")
-
+	rows := make([][]any, 0, len(signatures))
 	for _, signature := range signatures {
-		builder = builder.Values(signature.BlockID, signature.BlockIDFlag, signature.ValidatorAddress, signature.Timestamp, signature.Signature)
-	}
-
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return errors.New(err, "Build SQL for AddCommitSignatures")
+		rows = append(rows, []any{signature.BlockID, signature.BlockHeight, signature.BlockIDFlag, signature.ValidatorAddress, signature.Timestamp, signature.Signature})
 	}
 
-	_, err = p.exec.ExecContext(ctx, query, args...)
-	return errors.New(err, "Exec SQL for AddCommitSignatures")
+	return p.insertRows(ctx, "AddCommitSignatures", commitSignaturesTable, []string{"block_id", "block_height", "block_id_flag", "validator_address", "timestamp", "signature"}, rows)
 }
 
 func (p *postgres) GetCommitsCount(ctx context.Context, validatorAddress []byte, start, end int64) (int64, error) {
 	query, args, err := p.psql.Select("COUNT(*)").
 		From(commitSignaturesTable).
-		Join(blocksTable + " USING (block_id)").
 		Where(sq.Eq{"validator_address": validatorAddress}).
-		Where(sq.GtOrEq{"header_height": start}).
-		Where(sq.LtOrEq{"header_height": end}).
+		Where(sq.GtOrEq{"block_height": start}).
+		Where(sq.LtOrEq{"block_height": end}).
 		ToSql()
 	if err != nil {
 		return 0, errors.New(err, "Build SQL for GetCommitsCount")
 	}
 
 	var cnt int64
-	err = p.exec.QueryRowContext(ctx, query, args...).Scan(&cnt)
+	err = p.reader().QueryRowContext(ctx, query, args...).Scan(&cnt)
 	if err == sql.ErrNoRows {
 		return 0, repository.ErrNotFound
 	}
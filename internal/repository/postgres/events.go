@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (p *postgres) AddBlockEvents(ctx context.Context, events ...repository.BlockEvent) error {
+	rows := make([][]any, 0, len(events))
+	for _, event := range events {
+		attributes, err := json.Marshal(event.Attributes)
+		if err != nil {
+			return errors.New(err, "Marshal event attributes")
+		}
+		rows = append(rows, []any{event.BlockID, event.BlockHeight, event.Kind, event.EventPos, event.Type, event.TxHash, string(attributes)})
+	}
+
+	return p.insertRows(ctx, "AddBlockEvents", blockEventsTable, []string{"block_id", "block_height", "kind", "event_pos", "type", "tx_hash", "attributes"}, rows)
+}
+
+func (p *postgres) GetBlockEvents(ctx context.Context, filter repository.EventFilter) ([]repository.BlockEvent, error) {
+	builder := p.psql.Select("block_id", "block_height", "kind", "event_pos", "type", "tx_hash", "attributes").
+		From(blockEventsTable)
+
+	if filter.Type != "" {
+		builder = builder.Where(sq.Eq{"type": filter.Type})
+	}
+	if len(filter.TxHash) != 0 {
+		builder = builder.Where(sq.Eq{"tx_hash": filter.TxHash})
+	}
+	if filter.FromHeight > 0 {
+		builder = builder.Where(sq.GtOrEq{"block_height": filter.FromHeight})
+	}
+	if filter.ToHeight > 0 {
+		builder = builder.Where(sq.LtOrEq{"block_height": filter.ToHeight})
+	}
+	if filter.Key != "" {
+		attribute := map[string]string{"key": filter.Key}
+		if filter.Value != "" {
+			attribute["value"] = filter.Value
+		}
+		contains, err := json.Marshal([]map[string]string{attribute})
+		if err != nil {
+			return nil, errors.New(err, "Marshal attributes filter")
+		}
+		builder = builder.Where("attributes @> ?::jsonb", string(contains))
+	}
+	if filter.Limit != 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+	builder = builder.Offset(filter.Offset)
+
+	builder = builder.OrderBy("block_height DESC", "event_pos")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetBlockEvents")
+	}
+
+	rows, err := p.reader().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetBlockEvents")
+	}
+	defer rows.Close()
+
+	var events []repository.BlockEvent
+	for rows.Next() {
+		var (
+			event      repository.BlockEvent
+			attributes []byte
+		)
+		if err = rows.Scan(&event.BlockID, &event.BlockHeight, &event.Kind, &event.EventPos, &event.Type, &event.TxHash, &attributes); err != nil {
+			return nil, errors.New(err, "Scan result for GetBlockEvents")
+		}
+		if err = json.Unmarshal(attributes, &event.Attributes); err != nil {
+			return nil, errors.New(err, "Unmarshal event attributes")
+		}
+		events = append(events, event)
+	}
+
+	return events, nil
+}
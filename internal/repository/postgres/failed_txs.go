@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var failedTxsColumns = []string{"block_id", "block_height", "tx_pos", "tx_hash", "tx_type", "data", "error", "created_at"}
+
+func scanFailedTx(row interface{ Scan(dest ...any) error }) (repository.FailedTx, error) {
+	var tx repository.FailedTx
+	err := row.Scan(&tx.BlockID, &tx.BlockHeight, &tx.TxPos, &tx.TxHash, &tx.TxType, &tx.Data, &tx.Error, &tx.CreatedAt)
+	return tx, err
+}
+
+func (p *postgres) AddFailedTxs(ctx context.Context, txs ...repository.FailedTx) error {
+	rows := make([][]any, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []any{tx.BlockID, tx.BlockHeight, tx.TxPos, tx.TxHash, tx.TxType, tx.Data, tx.Error, tx.CreatedAt})
+	}
+
+	return p.insertRows(ctx, "AddFailedTxs", failedTxsTable, failedTxsColumns, rows)
+}
+
+func (p *postgres) GetFailedTxs(ctx context.Context, limit, offset uint64) ([]repository.FailedTx, error) {
+	builder := p.psql.Select(failedTxsColumns...).
+		From(failedTxsTable).
+		OrderBy("block_height DESC", "tx_pos DESC")
+
+	if limit != 0 {
+		builder = builder.Limit(limit)
+	}
+	builder = builder.Offset(offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetFailedTxs")
+	}
+
+	rows, err := p.reader().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetFailedTxs")
+	}
+	defer rows.Close()
+
+	var txs []repository.FailedTx
+	for rows.Next() {
+		tx, err := scanFailedTx(rows)
+		if err != nil {
+			return nil, errors.New(err, "Scan result for GetFailedTxs")
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (p *postgres) GetFailedTxHeights(ctx context.Context) ([]int64, error) {
+	query, args, err := p.psql.Select("DISTINCT block_height").
+		From(failedTxsTable).
+		OrderBy("block_height").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetFailedTxHeights")
+	}
+
+	rows, err := p.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetFailedTxHeights")
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err = rows.Scan(&height); err != nil {
+			return nil, errors.New(err, "Scan result for GetFailedTxHeights")
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, nil
+}
+
+func (p *postgres) GetBlockFailedTxs(ctx context.Context, height int64) ([]repository.FailedTx, error) {
+	query, args, err := p.psql.Select(failedTxsColumns...).
+		From(failedTxsTable).
+		Where(sq.Eq{"block_height": height}).
+		OrderBy("tx_pos").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetBlockFailedTxs")
+	}
+
+	rows, err := p.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetBlockFailedTxs")
+	}
+	defer rows.Close()
+
+	var txs []repository.FailedTx
+	for rows.Next() {
+		tx, err := scanFailedTx(rows)
+		if err != nil {
+			return nil, errors.New(err, "Scan result for GetBlockFailedTxs")
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (p *postgres) DeleteFailedTx(ctx context.Context, height, txPos int64) error {
+	query, args, err := p.psql.Delete(failedTxsTable).
+		Where(sq.Eq{"block_height": height, "tx_pos": txPos}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteFailedTx")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteFailedTx")
+}
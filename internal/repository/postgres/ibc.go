@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var ibcTransfersColumns = []string{"tx_hash", "block_height", "tx_pos", "msg_type", "source_port", "source_channel", "destination_port",
+	"destination_channel", "sequence", "denom", "amount", "sender", "receiver", "memo"}
+
+func (p *postgres) AddIbcTransfers(ctx context.Context, transfers ...repository.IbcTransfer) error {
+	rows := make([][]any, 0, len(transfers))
+	for _, t := range transfers {
+		rows = append(rows, []any{t.TxHash, t.BlockHeight, t.TxPos, t.MsgType, t.SourcePort, t.SourceChannel, t.DestinationPort,
+			t.DestinationChannel, t.Sequence, t.Denom, t.Amount, t.Sender, t.Receiver, t.Memo})
+	}
+
+	return p.insertRows(ctx, "AddIbcTransfers", ibcTransfersTable, ibcTransfersColumns, rows)
+}
+
+func (p *postgres) GetIbcTransfers(ctx context.Context, filter repository.IbcTransferFilter) ([]repository.IbcTransfer, error) {
+	builder := p.psql.Select(ibcTransfersColumns...).
+		From(ibcTransfersTable).
+		OrderBy("block_height DESC", "tx_pos DESC")
+
+	if filter.Channel != "" {
+		builder = builder.Where(sq.Or{sq.Eq{"source_channel": filter.Channel}, sq.Eq{"destination_channel": filter.Channel}})
+	}
+	if filter.Denom != "" {
+		builder = builder.Where(sq.Eq{"denom": filter.Denom})
+	}
+	if filter.Limit != 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+	builder = builder.Offset(filter.Offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetIbcTransfers")
+	}
+
+	rows, err := p.reader().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetIbcTransfers")
+	}
+	defer rows.Close()
+
+	var transfers []repository.IbcTransfer
+	for rows.Next() {
+		var t repository.IbcTransfer
+		if err = rows.Scan(&t.TxHash, &t.BlockHeight, &t.TxPos, &t.MsgType, &t.SourcePort, &t.SourceChannel, &t.DestinationPort,
+			&t.DestinationChannel, &t.Sequence, &t.Denom, &t.Amount, &t.Sender, &t.Receiver, &t.Memo); err != nil {
+			return nil, errors.New(err, "Scan result for GetIbcTransfers")
+		}
+		transfers = append(transfers, t)
+	}
+
+	return transfers, nil
+}
+
+func (p *postgres) DeleteIbcTransfers(ctx context.Context, txHash []byte) error {
+	query, args, err := p.psql.Delete(ibcTransfersTable).Where(sq.Eq{"tx_hash": txHash}).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteIbcTransfers")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteIbcTransfers")
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var maspTxsColumns = []string{"tx_hash", "block_height", "tx_pos", "masp_pos", "expiry_height", "spends_count", "converts_count",
+	"outputs_count", "value_balance", "transparent_inputs", "transparent_outputs"}
+
+func (p *postgres) AddMaspTxs(ctx context.Context, txs ...repository.MaspTx) error {
+	rows := make([][]any, 0, len(txs))
+	for _, t := range txs {
+		valueBalance, err := json.Marshal(t.ValueBalance)
+		if err != nil {
+			return errors.New(err, "Marshal MASP value balance")
+		}
+		inputs, err := json.Marshal(t.TransparentInputs)
+		if err != nil {
+			return errors.New(err, "Marshal MASP transparent inputs")
+		}
+		outputs, err := json.Marshal(t.TransparentOutputs)
+		if err != nil {
+			return errors.New(err, "Marshal MASP transparent outputs")
+		}
+		rows = append(rows, []any{t.TxHash, t.BlockHeight, t.TxPos, t.MaspPos, t.ExpiryHeight, t.SpendsCount, t.ConvertsCount,
+			t.OutputsCount, string(valueBalance), string(inputs), string(outputs)})
+	}
+
+	return p.insertRows(ctx, "AddMaspTxs", maspTxsTable, maspTxsColumns, rows)
+}
+
+func (p *postgres) GetMaspTxs(ctx context.Context, txHash []byte) ([]repository.MaspTx, error) {
+	query, args, err := p.psql.Select(maspTxsColumns...).
+		From(maspTxsTable).
+		Where(sq.Eq{"tx_hash": txHash}).
+		OrderBy("masp_pos").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetMaspTxs")
+	}
+
+	rows, err := p.reader().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetMaspTxs")
+	}
+	defer rows.Close()
+
+	var txs []repository.MaspTx
+	for rows.Next() {
+		var (
+			t                             repository.MaspTx
+			valueBalance, inputs, outputs []byte
+		)
+		if err = rows.Scan(&t.TxHash, &t.BlockHeight, &t.TxPos, &t.MaspPos, &t.ExpiryHeight, &t.SpendsCount, &t.ConvertsCount,
+			&t.OutputsCount, &valueBalance, &inputs, &outputs); err != nil {
+			return nil, errors.New(err, "Scan result for GetMaspTxs")
+		}
+		if err = json.Unmarshal(valueBalance, &t.ValueBalance); err != nil {
+			return nil, errors.New(err, "Unmarshal MASP value balance")
+		}
+		if err = json.Unmarshal(inputs, &t.TransparentInputs); err != nil {
+			return nil, errors.New(err, "Unmarshal MASP transparent inputs")
+		}
+		if err = json.Unmarshal(outputs, &t.TransparentOutputs); err != nil {
+			return nil, errors.New(err, "Unmarshal MASP transparent outputs")
+		}
+		txs = append(txs, t)
+	}
+
+	return txs, nil
+}
+
+func (p *postgres) DeleteMaspTxs(ctx context.Context, txHash []byte) error {
+	query, args, err := p.psql.Delete(maspTxsTable).Where(sq.Eq{"tx_hash": txHash}).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteMaspTxs")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteMaspTxs")
+}
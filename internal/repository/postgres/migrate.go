@@ -0,0 +1,289 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// loadMigrations reads migrations named <version>_<name>.up.sql and <version>_<name>.down.sql
+// ordered by version.
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, errors.New(err, "Read migrations dir")
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		base, direction := strings.TrimSuffix(fileName, ".sql"), ""
+		switch {
+		case strings.HasSuffix(base, ".up"):
+			base, direction = strings.TrimSuffix(base, ".up"), "up"
+		case strings.HasSuffix(base, ".down"):
+			base, direction = strings.TrimSuffix(base, ".down"), "down"
+		default:
+			return nil, errors.Create("Invalid migration file name " + fileName)
+		}
+
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, errors.Create("Invalid migration file name " + fileName)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, errors.New(err, "Parse migration version "+fileName)
+		}
+
+		content, err := migrationFiles.ReadFile(path.Join("migrations", fileName))
+		if err != nil {
+			return nil, errors.New(err, "Read migration "+fileName)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: name}
+			byVersion[version] = m
+		} else if m.name != name {
+			return nil, errors.Create(fmt.Sprintf("Migration %d has different names: %s and %s", version, m.name, name))
+		}
+		if direction == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, errors.Create(fmt.Sprintf("Migration %d_%s has no up script", m.version, m.name))
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
+
+// withMigrationLock runs fn on a dedicated connection holding a session advisory lock for the schema,
+// so the indexer and the server starting together don't apply the same migrations twice.
+func (p *postgres) withMigrationLock(ctx context.Context, fn func(conn *sql.Conn) error) (err error) {
+	if p.db == nil {
+		return errors.Create("Can't run migrations inside of transaction")
+	}
+
+	conn, err := p.db.Conn(ctx)
+	if err != nil {
+		return errors.New(err, "Get db connection")
+	}
+	defer conn.Close()
+
+	if _, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock(hashtext($1))", p.config.Schema); err != nil {
+		return errors.New(err, "Acquire migration lock")
+	}
+	defer func() {
+		// Use background context, the lock must be released even if ctx is cancelled
+		_, unlockErr := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock(hashtext($1))", p.config.Schema)
+		if unlockErr != nil {
+			logger.Error("Release migration lock failed", zap.Error(unlockErr))
+		}
+	}()
+
+	if _, err = conn.ExecContext(ctx, "CREATE SCHEMA IF NOT EXISTS "+p.config.Schema); err != nil {
+		return errors.New(err, "Create schema")
+	}
+
+	_, err = conn.ExecContext(ctx, fmt.Sprintf(`
+	CREATE TABLE IF NOT EXISTS %s (
+		version BIGINT PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	);`, schemaMigrationsTable))
+	if err != nil {
+		return errors.New(err, "Create schema migrations table")
+	}
+
+	return fn(conn)
+}
+
+func getAppliedMigrations(ctx context.Context, conn *sql.Conn) (map[int64]repository.Migration, error) {
+	rows, err := conn.QueryContext(ctx, "SELECT version, name, applied_at FROM "+schemaMigrationsTable)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for getAppliedMigrations")
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]repository.Migration)
+	for rows.Next() {
+		var m repository.Migration
+		var appliedAt time.Time
+		if err = rows.Scan(&m.Version, &m.Name, &appliedAt); err != nil {
+			return nil, errors.New(err, "Scan result for getAppliedMigrations")
+		}
+		m.AppliedAt = &appliedAt
+		applied[m.Version] = m
+	}
+
+	return applied, errors.New(rows.Err(), "Read rows for getAppliedMigrations")
+}
+
+// runMigration executes migration script and updates schema_migrations in one database transaction.
+func (p *postgres) runMigration(ctx context.Context, conn *sql.Conn, script string, record func(tx *sql.Tx) error) (err error) {
+	tx, err := conn.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return errors.New(err, "Begin db tx")
+	}
+	defer func() {
+		if err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				err = errors.New(err, rErr.Error())
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	// Scripts use unqualified table names
+	if _, err = tx.ExecContext(ctx, "SET LOCAL search_path TO "+p.config.Schema); err != nil {
+		return errors.New(err, "Set search path")
+	}
+	if _, err = tx.ExecContext(ctx, script); err != nil {
+		return errors.New(err, "Exec migration script")
+	}
+
+	return record(tx)
+}
+
+func (p *postgres) MigrateUp(ctx context.Context) (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	err = p.withMigrationLock(ctx, func(conn *sql.Conn) error {
+		appliedMigrations, err := getAppliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if _, ok := appliedMigrations[m.version]; ok {
+				continue
+			}
+
+			logger.Info("Applying migration", zap.Int64("version", m.version), zap.String("name", m.name))
+
+			err = p.runMigration(ctx, conn, m.up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, "INSERT INTO "+schemaMigrationsTable+" (version, name, applied_at) VALUES ($1, $2, $3)",
+					m.version, m.name, time.Now())
+				return errors.New(err, "Exec SQL for MigrateUp")
+			})
+			if err != nil {
+				return errors.New(err, fmt.Sprintf("Apply migration %d_%s", m.version, m.name))
+			}
+			applied++
+		}
+		return nil
+	})
+
+	return applied, err
+}
+
+func (p *postgres) MigrateDown(ctx context.Context, steps int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	return p.withMigrationLock(ctx, func(conn *sql.Conn) error {
+		appliedMigrations, err := getAppliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+			m := migrations[i]
+			if _, ok := appliedMigrations[m.version]; !ok {
+				continue
+			}
+			if m.down == "" {
+				return errors.Create(fmt.Sprintf("Migration %d_%s can't be reverted", m.version, m.name))
+			}
+
+			logger.Info("Reverting migration", zap.Int64("version", m.version), zap.String("name", m.name))
+
+			err = p.runMigration(ctx, conn, m.down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, "DELETE FROM "+schemaMigrationsTable+" WHERE version = $1", m.version)
+				return errors.New(err, "Exec SQL for MigrateDown")
+			})
+			if err != nil {
+				return errors.New(err, fmt.Sprintf("Revert migration %d_%s", m.version, m.name))
+			}
+			steps--
+		}
+		return nil
+	})
+}
+
+// MigrationStatus returns all known migrations ordered by version, AppliedAt is nil for pending ones.
+// Applied migrations unknown to this binary are included too.
+func (p *postgres) MigrationStatus(ctx context.Context) ([]repository.Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var status []repository.Migration
+	err = p.withMigrationLock(ctx, func(conn *sql.Conn) error {
+		appliedMigrations, err := getAppliedMigrations(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if applied, ok := appliedMigrations[m.version]; ok {
+				status = append(status, applied)
+				delete(appliedMigrations, m.version)
+			} else {
+				status = append(status, repository.Migration{Version: m.version, Name: m.name})
+			}
+		}
+		for _, applied := range appliedMigrations {
+			status = append(status, applied)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(status, func(i, j int) bool { return status[i].Version < status[j].Version })
+
+	return status, nil
+}
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS account_transactions;
+DROP TABLE IF EXISTS commit_signatures;
+DROP TABLE IF EXISTS evidences;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS blocks;
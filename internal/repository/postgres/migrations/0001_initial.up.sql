@@ -0,0 +1,83 @@
+CREATE TABLE IF NOT EXISTS blocks (
+	block_id BYTEA NOT NULL,
+	header_version_app BIGINT NOT NULL,
+	header_version_block BIGINT NOT NULL,
+	header_chain_id TEXT NOT NULL,
+	header_height BIGINT NOT NULL,
+	header_time TIMESTAMP NOT NULL,
+	header_last_block_id_hash BYTEA,
+	header_last_block_id_parts_header_total BIGINT,
+	header_last_block_id_parts_header_hash BYTEA,
+	header_last_commit_hash BYTEA,
+	header_data_hash BYTEA,
+	header_validators_hash BYTEA NOT NULL,
+	header_next_validators_hash BYTEA NOT NULL,
+	header_consensus_hash BYTEA NOT NULL,
+	header_app_hash BYTEA NOT NULL,
+	header_last_results_hash BYTEA,
+	header_evidence_hash BYTEA,
+	header_proposer_address BYTEA NOT NULL,
+	commit_height BIGINT,
+	commit_round BIGINT,
+	commit_block_id_hash BYTEA,
+	commit_block_id_parts_header_total BIGINT,
+	commit_block_id_parts_header_hash BYTEA
+);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	hash BYTEA NOT NULL,
+	block_id BYTEA NOT NULL,
+	tx_type TEXT NOT NULL,
+	wrapper_id BYTEA,
+	memo TEXT,
+	fee_amount_per_gas_unit TEXT,
+	fee_token TEXT,
+	gas_limit_multiplier BIGINT,
+	code BYTEA,
+	data JSONB,
+	return_code BIGINT,
+	pos_in_block BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS evidences (
+	block_id BYTEA NOT NULL,
+	height BIGINT NOT NULL,
+	time BIGINT NOT NULL,
+	address BYTEA,
+	total_voting_power BIGINT NOT NULL,
+	validator_power BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS commit_signatures (
+	block_id BYTEA NOT NULL,
+	block_id_flag INTEGER NOT NULL,
+	validator_address BYTEA NOT NULL,
+	timestamp BIGINT NOT NULL,
+	signature BYTEA NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS account_transactions (
+	address BYTEA NOT NULL,
+	tx_hash BYTEA NOT NULL,
+	block_height BIGINT NOT NULL,
+	tx_pos BIGINT NOT NULL
+);
+
+-- Databases created before migrations may already have these constraints
+DO $$
+BEGIN
+	IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'pk_blocks_block_id' AND conrelid = 'blocks'::regclass) THEN
+		ALTER TABLE blocks ADD CONSTRAINT pk_blocks_block_id PRIMARY KEY (block_id);
+	END IF;
+	IF NOT EXISTS (SELECT 1 FROM pg_constraint WHERE conname = 'fk_transactions_block_id' AND conrelid = 'transactions'::regclass) THEN
+		ALTER TABLE transactions ADD CONSTRAINT fk_transactions_block_id FOREIGN KEY (block_id) REFERENCES blocks (block_id);
+	END IF;
+END
+$$;
+
+CREATE UNIQUE INDEX IF NOT EXISTS blocks_header_height_unique ON blocks (header_height);
+CREATE INDEX IF NOT EXISTS transactions_block_id_idx ON transactions USING hash(block_id);
+CREATE INDEX IF NOT EXISTS transactions_hash_idx ON transactions USING hash(hash);
+CREATE INDEX IF NOT EXISTS transactions_memo_idx ON transactions USING hash(memo) WHERE memo IS NOT NULL;
+CREATE INDEX IF NOT EXISTS account_transactions_address_idx ON account_transactions USING hash(address);
+CREATE INDEX IF NOT EXISTS commit_signatures_block_idx ON commit_signatures USING hash(block_id);
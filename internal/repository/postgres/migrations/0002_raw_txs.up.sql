@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS raw_txs (
+	tx_hash BYTEA NOT NULL,
+	block_id BYTEA NOT NULL,
+	block_height BIGINT NOT NULL,
+	tx_pos BIGINT NOT NULL,
+	data BYTEA NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS raw_txs_tx_hash_idx ON raw_txs USING hash(tx_hash);
+CREATE INDEX IF NOT EXISTS raw_txs_block_height_idx ON raw_txs (block_height);
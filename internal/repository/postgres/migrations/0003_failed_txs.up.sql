@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS failed_txs (
+	block_id BYTEA NOT NULL,
+	block_height BIGINT NOT NULL,
+	tx_pos BIGINT NOT NULL,
+	tx_hash BYTEA,
+	data BYTEA NOT NULL,
+	error TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS failed_txs_block_height_idx ON failed_txs (block_height);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS block_events (
+	block_id BYTEA NOT NULL,
+	block_height BIGINT NOT NULL,
+	kind TEXT NOT NULL,
+	event_pos BIGINT NOT NULL,
+	type TEXT NOT NULL,
+	tx_hash BYTEA,
+	attributes JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS block_events_type_height_idx ON block_events (type, block_height);
+CREATE INDEX IF NOT EXISTS block_events_block_height_idx ON block_events (block_height);
+CREATE INDEX IF NOT EXISTS block_events_tx_hash_idx ON block_events USING hash(tx_hash) WHERE tx_hash IS NOT NULL;
+CREATE INDEX IF NOT EXISTS block_events_attributes_idx ON block_events USING gin(attributes jsonb_path_ops);
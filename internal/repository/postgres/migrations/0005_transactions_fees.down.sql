@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS transactions_wrapper_id_idx;
+
+ALTER TABLE transactions
+	DROP COLUMN IF EXISTS gas_used,
+	DROP COLUMN IF EXISTS fee_paid;
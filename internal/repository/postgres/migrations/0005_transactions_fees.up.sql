@@ -0,0 +1,5 @@
+ALTER TABLE transactions
+	ADD COLUMN IF NOT EXISTS gas_used BIGINT,
+	ADD COLUMN IF NOT EXISTS fee_paid NUMERIC;
+
+CREATE INDEX IF NOT EXISTS transactions_wrapper_id_idx ON transactions USING hash(wrapper_id) WHERE wrapper_id IS NOT NULL;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS indexer_status (
+	id SMALLINT PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+	indexed_height BIGINT NOT NULL,
+	chain_height BIGINT NOT NULL,
+	last_block_time TIMESTAMP,
+	sync_state TEXT NOT NULL,
+	version TEXT NOT NULL,
+	checksums TEXT NOT NULL,
+	error_count BIGINT NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+);
@@ -0,0 +1,6 @@
+-- Fails if the tables are partitioned by block height
+DROP INDEX IF EXISTS commit_signatures_validator_height_idx;
+DROP INDEX IF EXISTS transactions_block_height_idx;
+
+ALTER TABLE commit_signatures DROP COLUMN IF EXISTS block_height;
+ALTER TABLE transactions DROP COLUMN IF EXISTS block_height;
@@ -0,0 +1,17 @@
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS block_height BIGINT;
+ALTER TABLE commit_signatures ADD COLUMN IF NOT EXISTS block_height BIGINT;
+
+UPDATE transactions t SET block_height = b.header_height
+FROM blocks b
+WHERE b.block_id = t.block_id AND t.block_height IS NULL;
+
+UPDATE commit_signatures c SET block_height = b.header_height
+FROM blocks b
+WHERE b.block_id = c.block_id AND c.block_height IS NULL;
+
+-- Block height is the partition key when partitioning is enabled
+ALTER TABLE transactions ALTER COLUMN block_height SET NOT NULL;
+ALTER TABLE commit_signatures ALTER COLUMN block_height SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS transactions_block_height_idx ON transactions (block_height);
+CREATE INDEX IF NOT EXISTS commit_signatures_validator_height_idx ON commit_signatures (validator_address, block_height);
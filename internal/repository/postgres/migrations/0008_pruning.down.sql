@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS blocks_header_time_idx;
+
+DROP TABLE IF EXISTS pruned_shielded;
+DROP TABLE IF EXISTS pruned_account_totals;
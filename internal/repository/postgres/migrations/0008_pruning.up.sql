@@ -0,0 +1,12 @@
+-- Aggregates of pruned rows which are added to totals calculated from remaining rows
+CREATE TABLE IF NOT EXISTS pruned_account_totals (
+	address BYTEA PRIMARY KEY,
+	txs_count BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pruned_shielded (
+	token TEXT PRIMARY KEY,
+	amount NUMERIC NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS blocks_header_time_idx ON blocks (header_time);
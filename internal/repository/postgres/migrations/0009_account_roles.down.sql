@@ -0,0 +1 @@
+ALTER TABLE account_transactions DROP COLUMN IF EXISTS role;
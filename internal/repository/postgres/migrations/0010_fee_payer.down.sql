@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS transactions_fee_payer_idx;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS fee_payer;
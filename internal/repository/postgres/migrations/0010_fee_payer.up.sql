@@ -0,0 +1,4 @@
+-- Implicit address of the wrapper public key, wrappers indexed before have an empty fee payer until reindexed
+ALTER TABLE transactions ADD COLUMN IF NOT EXISTS fee_payer TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS transactions_fee_payer_idx ON transactions (fee_payer) WHERE fee_payer <> '';
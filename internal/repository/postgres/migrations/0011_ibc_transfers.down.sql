@@ -0,0 +1 @@
+DROP TABLE IF EXISTS ibc_transfers;
@@ -0,0 +1,17 @@
+-- MASP transactions of txs with counts of shielded descriptions, value balance and transparent inputs and outputs
+CREATE TABLE IF NOT EXISTS masp_txs (
+	tx_hash BYTEA NOT NULL,
+	block_height BIGINT NOT NULL,
+	tx_pos BIGINT NOT NULL,
+	masp_pos BIGINT NOT NULL,
+	expiry_height BIGINT NOT NULL,
+	spends_count BIGINT NOT NULL,
+	converts_count BIGINT NOT NULL,
+	outputs_count BIGINT NOT NULL,
+	value_balance JSONB NOT NULL,
+	transparent_inputs JSONB NOT NULL,
+	transparent_outputs JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS masp_txs_tx_hash_idx ON masp_txs USING hash(tx_hash);
+CREATE INDEX IF NOT EXISTS masp_txs_block_height_idx ON masp_txs (block_height);
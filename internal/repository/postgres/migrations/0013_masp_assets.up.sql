@@ -0,0 +1,10 @@
+-- Asset data of MASP asset types: token, denomination, digit position and epoch
+CREATE TABLE IF NOT EXISTS masp_assets (
+	asset_type BYTEA PRIMARY KEY,
+	token TEXT NOT NULL,
+	denom SMALLINT NOT NULL,
+	position SMALLINT NOT NULL,
+	epoch BIGINT
+);
+
+CREATE INDEX IF NOT EXISTS masp_assets_token_idx ON masp_assets (token);
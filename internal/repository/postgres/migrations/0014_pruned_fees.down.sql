@@ -0,0 +1 @@
+DROP TABLE IF EXISTS pruned_fees;
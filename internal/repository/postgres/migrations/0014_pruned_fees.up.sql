@@ -0,0 +1,11 @@
+-- Fee aggregates of pruned wrapper txs per day, fee payer and fee token
+CREATE TABLE IF NOT EXISTS pruned_fees (
+	day TIMESTAMP NOT NULL,
+	fee_payer TEXT NOT NULL,
+	fee_token TEXT NOT NULL,
+	txs_count BIGINT NOT NULL,
+	gas_limit BIGINT NOT NULL,
+	gas_used BIGINT NOT NULL,
+	fee_paid NUMERIC NOT NULL,
+	PRIMARY KEY (day, fee_payer, fee_token)
+);
@@ -0,0 +1,18 @@
+-- Transactions saved with zero block height get the height of their block
+UPDATE transactions t SET block_height = b.header_height
+FROM blocks b
+WHERE b.block_id = t.block_id AND t.block_height <> b.header_height;
+
+CREATE INDEX IF NOT EXISTS account_transactions_tx_hash_idx ON account_transactions USING hash(tx_hash);
+CREATE INDEX IF NOT EXISTS account_transactions_block_height_idx ON account_transactions (block_height);
+
+-- Txs by source or target are found by account transactions, rows saved before account roles have only the source
+INSERT INTO account_transactions (address, tx_hash, block_height, tx_pos, role)
+SELECT convert_to(t.data ->> r.role, 'UTF8'), t.hash, t.block_height, t.pos_in_block, r.role
+FROM transactions t
+CROSS JOIN (VALUES ('source'), ('target')) AS r (role)
+WHERE t.tx_type = 'Decrypted' AND t.data ->> r.role IS NOT NULL
+	AND NOT EXISTS (
+		SELECT 1 FROM account_transactions a
+		WHERE a.tx_hash = t.hash AND a.block_height = t.block_height AND a.role = r.role
+	);
@@ -0,0 +1,5 @@
+-- Type of the failed tx, inferred from its position in the block if it can't be decoded.
+-- Txs quarantined before have an empty type, the indexer decodes their data to get it
+ALTER TABLE failed_txs ADD COLUMN IF NOT EXISTS tx_type TEXT NOT NULL DEFAULT '';
+
+CREATE INDEX IF NOT EXISTS failed_txs_block_height_tx_pos_idx ON failed_txs (block_height, tx_pos);
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// partitions keeps the height up to which partitions are created, it is shared by all runners of the repository.
+type partitions struct {
+	mu   sync.Mutex
+	upTo int64
+}
+
+// partitionedTables returns tables partitioned by block height when partitioning is enabled.
+func partitionedTables() []string {
+	return []string{transactionsTable, commitSignaturesTable}
+}
+
+// EnsurePartitions creates partitions covering heights up to height plus one more partition ahead.
+// Not partitioned tables are converted first, which copies all their rows.
+func (p *postgres) EnsurePartitions(ctx context.Context, height int64) error {
+	size := p.config.PartitionSize
+	if size <= 0 {
+		return nil
+	}
+
+	p.partitions.mu.Lock()
+	defer p.partitions.mu.Unlock()
+
+	if height < p.partitions.upTo-size {
+		return nil
+	}
+
+	upTo := (height/size + 2) * size
+
+	for _, table := range partitionedTables() {
+		var partitioned bool
+		err := p.exec.QueryRowContext(ctx, "SELECT EXISTS (SELECT 1 FROM pg_partitioned_table WHERE partrelid = $1::regclass)", table).Scan(&partitioned)
+		if err != nil {
+			return errors.New(err, "Check partitioning of "+table)
+		}
+
+		if !partitioned {
+			if err = p.partitionTable(ctx, table, upTo); err != nil {
+				return errors.New(err, "Partition "+table)
+			}
+			continue
+		}
+
+		if err = p.createPartitions(ctx, p.exec, table, p.partitions.upTo, upTo); err != nil {
+			return err
+		}
+	}
+
+	p.partitions.upTo = upTo
+
+	return nil
+}
+
+// createPartitions creates missing partitions of the table for heights in [fromHeight, toHeight).
+func (p *postgres) createPartitions(ctx context.Context, exec executor, table string, fromHeight, toHeight int64) error {
+	size := p.config.PartitionSize
+	for start := fromHeight / size * size; start < toHeight; start += size {
+		query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s_p%d PARTITION OF %s FOR VALUES FROM (%d) TO (%d)", table, start, table, start, start+size)
+		if _, err := exec.ExecContext(ctx, query); err != nil {
+			return errors.New(err, fmt.Sprintf("Create partition %s_p%d", table, start))
+		}
+	}
+	return nil
+}
+
+// partitionTable replaces the table with a table partitioned by block height with the same rows,
+// indexes and foreign keys in a single database transaction.
+func (p *postgres) partitionTable(ctx context.Context, table string, upTo int64) (err error) {
+	if p.db == nil {
+		return errors.Create("Can't partition table inside of transaction")
+	}
+
+	logger.Warn("Partitioning table, it may take a while", zap.String("table", table))
+
+	tx, err := p.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return errors.New(err, "Begin db tx")
+	}
+	defer func() {
+		if err != nil {
+			if rErr := tx.Rollback(); rErr != nil {
+				err = errors.New(err, rErr.Error())
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	schema := strings.Trim(p.config.Schema, `"`)
+	name := table[strings.LastIndex(table, ".")+1:]
+	old := name + "_unpartitioned"
+
+	// Definitions are taken before renaming, so they refer to the new table
+	indexDefs, err := queryStrings(ctx, tx, "SELECT indexdef FROM pg_indexes WHERE schemaname = $1 AND tablename = $2", schema, name)
+	if err != nil {
+		return errors.New(err, "Get indexes")
+	}
+	constraintDefs, err := queryStrings(ctx, tx, `SELECT 'ALTER TABLE `+table+` ADD CONSTRAINT ' || quote_ident(conname) || ' ' || pg_get_constraintdef(oid)
+		FROM pg_constraint WHERE conrelid = $1::regclass AND contype = 'f'`, table)
+	if err != nil {
+		return errors.New(err, "Get foreign keys")
+	}
+
+	var maxHeight int64
+	if err = tx.QueryRowContext(ctx, "SELECT COALESCE(MAX(block_height), 0) FROM "+table).Scan(&maxHeight); err != nil {
+		return errors.New(err, "Get max height")
+	}
+
+	queries := []string{
+		fmt.Sprintf("ALTER TABLE %s RENAME TO %s", table, old),
+		fmt.Sprintf("CREATE TABLE %s (LIKE %s.%s INCLUDING DEFAULTS) PARTITION BY RANGE (block_height)", table, p.config.Schema, old),
+	}
+	for _, query := range queries {
+		if _, err = tx.ExecContext(ctx, query); err != nil {
+			return errors.New(err, "Exec SQL for partitionTable")
+		}
+	}
+
+	if err = p.createPartitions(ctx, tx, table, 0, max(upTo, (maxHeight/p.config.PartitionSize+1)*p.config.PartitionSize)); err != nil {
+		return err
+	}
+
+	queries = []string{
+		fmt.Sprintf("INSERT INTO %s SELECT * FROM %s.%s", table, p.config.Schema, old),
+		fmt.Sprintf("DROP TABLE %s.%s", p.config.Schema, old),
+	}
+	queries = append(queries, indexDefs...)
+	queries = append(queries, constraintDefs...)
+	for _, query := range queries {
+		if _, err = tx.ExecContext(ctx, query); err != nil {
+			return errors.New(err, "Exec SQL for partitionTable")
+		}
+	}
+
+	logger.Info("Table partitioned", zap.String("table", table))
+
+	return nil
+}
+
+func queryStrings(ctx context.Context, exec executor, query string, args ...any) ([]string, error) {
+	rows, err := exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []string
+	for rows.Next() {
+		var s string
+		if err = rows.Scan(&s); err != nil {
+			return nil, err
+		}
+		result = append(result, s)
+	}
+	return result, rows.Err()
+}
@@ -3,7 +3,6 @@ package postgres
 import (
 	"context"
 	"database/sql"
-	"fmt"
 
 	sq "github.com/Masterminds/squirrel"
 	_ "github.com/lib/pq"
@@ -19,12 +18,16 @@ type postgres struct {
 	db     *sql.DB
 	exec   executor
 	psql   sq.StatementBuilderType
+
+	partitions *partitions
+	replicas   *replicas
 }
 
 type executor interface {
 	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
 	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
 	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+	PrepareContext(ctx context.Context, query string) (*sql.Stmt, error)
 }
 
 var (
@@ -33,13 +36,23 @@ var (
 	commitSignaturesTable    = "commit_signatures"
 	transactionsTable        = "transactions"
 	accountTransactionsTable = "account_transactions"
+	rawTxsTable              = "raw_txs"
+	failedTxsTable           = "failed_txs"
+	blockEventsTable         = "block_events"
+	indexerStatusTable       = "indexer_status"
+	schemaMigrationsTable    = "schema_migrations"
+	prunedAccountTotalsTable = "pruned_account_totals"
+	prunedShieldedTable      = "pruned_shielded"
+	prunedFeesTable          = "pruned_fees"
+	ibcTransfersTable        = "ibc_transfers"
+	maspTxsTable             = "masp_txs"
+	maspAssetsTable          = "masp_assets"
 )
 
 func NewRepository(ctx context.Context, config repository.Config) (*postgres, error) {
-	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable&connect_timeout=%d",
-		config.User, config.Password, config.Host, config.Port, config.DbName, config.ConnectionTimeout))
+	db, err := openDB(config)
 	if err != nil {
-		return nil, errors.New(err, "Open sql connection")
+		return nil, err
 	}
 	if err = db.PingContext(ctx); err != nil {
 		return nil, errors.New(err, "Ping db")
@@ -51,118 +64,42 @@ func NewRepository(ctx context.Context, config repository.Config) (*postgres, er
 	commitSignaturesTable = config.Schema + "." + commitSignaturesTable
 	transactionsTable = config.Schema + "." + transactionsTable
 	accountTransactionsTable = config.Schema + "." + accountTransactionsTable
-
-	return &postgres{
+	rawTxsTable = config.Schema + "." + rawTxsTable
+	failedTxsTable = config.Schema + "." + failedTxsTable
+	blockEventsTable = config.Schema + "." + blockEventsTable
+	indexerStatusTable = config.Schema + "." + indexerStatusTable
+	schemaMigrationsTable = config.Schema + "." + schemaMigrationsTable
+	prunedAccountTotalsTable = config.Schema + "." + prunedAccountTotalsTable
+	prunedShieldedTable = config.Schema + "." + prunedShieldedTable
+	prunedFeesTable = config.Schema + "." + prunedFeesTable
+	ibcTransfersTable = config.Schema + "." + ibcTransfersTable
+	maspTxsTable = config.Schema + "." + maspTxsTable
+	maspAssetsTable = config.Schema + "." + maspAssetsTable
+
+	p := &postgres{
 		config: config,
 		db:     db,
 		exec:   db,
 		psql:   sq.StatementBuilder.PlaceholderFormat(sq.Dollar),
-	}, nil
-}
-
-func (p *postgres) Close() error {
-	if p.db == nil {
-		return nil
-	}
-	return p.db.Close()
-}
-
-func (p *postgres) CreateTables(ctx context.Context) error {
-	createSchemaQuery := "CREATE SCHEMA IF NOT EXISTS " + p.config.Schema
-	_, err := p.exec.ExecContext(ctx, createSchemaQuery)
-	if err != nil {
-		return errors.New(err, "Create schema")
-	}
-
-	_, err = p.exec.ExecContext(ctx, createBlocksTableQuery())
-	if err != nil {
-		return errors.New(err, "Create blocks table")
-	}
-
-	_, err = p.exec.ExecContext(ctx, createTransactionsTableQuery())
-	if err != nil {
-		return errors.New(err, "Create transactions table")
-	}
-
-	_, err = p.exec.ExecContext(ctx, createEvidencesTableQuery())
-	if err != nil {
-		return errors.New(err, "Create evidences table")
-	}
-
-	_, err = p.exec.ExecContext(ctx, createCommitSignaturesTableQuery())
-	if err != nil {
-		return errors.New(err, "Create commit signatures table")
-	}
-
-	_, err = p.exec.ExecContext(ctx, createAccountTransactionsTableQuery())
-	return errors.New(err, "Create account transactions table")
-}
 
-func (p *postgres) HasIndexes(ctx context.Context) (bool, error) {
-	query, args, err := p.psql.Select("indexname", "indexdef").From("pg_indexes").Where(sq.Eq{"tablename": blocksTable}).ToSql()
-	if err != nil {
-		return false, errors.New(err, "Has indexes build SQL")
+		partitions: &partitions{},
 	}
-	rows, err := p.exec.QueryContext(ctx, query, args...)
-	if err != nil {
-		return false, errors.New(err, "Has indexes exec SQL")
+	if len(config.Replicas) > 0 {
+		p.replicas = openReplicas(ctx, config)
 	}
-	defer rows.Close()
 
-	return rows.Next(), nil
+	return p, nil
 }
 
-func (p *postgres) CreateIndexes(ctx context.Context) error {
-	if !p.config.CreateIndex {
+func (p *postgres) Close() error {
+	if p.db == nil {
 		return nil
 	}
-
-	blockPK := "ALTER TABLE " + blocksTable + " ADD CONSTRAINT pk_blocks_block_id PRIMARY KEY (block_id);"
-	blockHeightIndex := "CREATE UNIQUE INDEX IF NOT EXISTS blocks_header_height_unique ON " + blocksTable + " (header_height);"
-	txFK := "ALTER TABLE " + transactionsTable + " ADD CONSTRAINT fk_transactions_block_id FOREIGN KEY (block_id) REFERENCES " + blocksTable + " (block_id);"
-	txBlockIDIndex := "CREATE INDEX IF NOT EXISTS transactions_block_id_idx ON " + transactionsTable + " USING hash(block_id);"
-	txHashIndex := "CREATE INDEX IF NOT EXISTS transactions_hash_idx ON " + transactionsTable + " USING hash(hash);"
-	txMemoIndex := "CREATE INDEX IF NOT EXISTS transactions_memo_idx ON " + transactionsTable + " USING hash(memo) WHERE memo IS NOT NULL;"
-	accountTxsIndex := "CREATE INDEX IF NOT EXISTS account_transactions_address_idx ON " + accountTransactionsTable + " USING hash(address);"
-	commitSigsIndex := "CREATE INDEX IF NOT EXISTS commit_signatures_block_idx ON " + commitSignaturesTable + " USING hash(block_id);"
-
-	_, err := p.exec.ExecContext(ctx, blockPK)
-	if err != nil {
-		return errors.New(err, "Create blocks primary key")
-	}
-
-	_, err = p.exec.ExecContext(ctx, blockHeightIndex)
-	if err != nil {
-		return errors.New(err, "Create blocks height index")
-	}
-
-	_, err = p.exec.ExecContext(ctx, txFK)
-	if err != nil {
-		return errors.New(err, "Create transactions foreign index")
-	}
-
-	_, err = p.exec.ExecContext(ctx, txBlockIDIndex)
-	if err != nil {
-		return errors.New(err, "Create transactions block id index")
+	replicasErr := p.replicas.close()
+	if err := p.db.Close(); err != nil {
+		return err
 	}
-
-	_, err = p.exec.ExecContext(ctx, txHashIndex)
-	if err != nil {
-		return errors.New(err, "Create transactions hash index")
-	}
-
-	_, err = p.exec.ExecContext(ctx, txMemoIndex)
-	if err != nil {
-		return errors.New(err, "Create transactions memo index")
-	}
-
-	_, err = p.exec.ExecContext(ctx, accountTxsIndex)
-	if err != nil {
-		return errors.New(err, "Create account transactions address index")
-	}
-
-	_, err = p.exec.ExecContext(ctx, commitSigsIndex)
-	return errors.New(err, "Create commit signatures block index")
+	return replicasErr
 }
 
 func (p *postgres) ExecContext(ctx context.Context, query string, args ...any) error {
@@ -196,7 +133,7 @@ func (p *postgres) RunInTransaction(ctx context.Context, txFunc repository.InTra
 		}
 	}()
 
-	runner := &postgres{p.config, nil, tx, p.psql}
+	runner := &postgres{p.config, nil, tx, p.psql, p.partitions, p.replicas}
 	err = txFunc(ctx, runner)
 
 	return
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/repository/repotest"
+)
+
+const testSchema = "namadexer_test"
+
+// testRepository keeps the connection open between tests of the suite.
+type testRepository struct {
+	*postgres
+}
+
+func (testRepository) Close() error {
+	return nil
+}
+
+func env(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+// TestRepository runs the conformance suite in a dedicated schema, it is skipped unless TEST_POSTGRES_HOST is set:
+//
+//	make run-postgres
+//	TEST_POSTGRES_HOST=127.0.0.1 go test ./internal/repository/postgres
+func TestRepository(t *testing.T) {
+	host := os.Getenv("TEST_POSTGRES_HOST")
+	if host == "" {
+		t.Skip("TEST_POSTGRES_HOST is not set")
+	}
+
+	partitionSize, err := strconv.ParseInt(env("TEST_POSTGRES_PARTITION_SIZE", "0"), 10, 64)
+	if err != nil {
+		t.Fatalf("Parse TEST_POSTGRES_PARTITION_SIZE: %v", err)
+	}
+
+	ctx := context.Background()
+
+	repo, err := NewRepository(ctx, repository.Config{
+		Host:              host,
+		Port:              env("TEST_POSTGRES_PORT", "5432"),
+		User:              env("TEST_POSTGRES_USER", "postgres"),
+		Password:          env("TEST_POSTGRES_PASSWORD", "1234"),
+		DbName:            env("TEST_POSTGRES_DB", "blockchain"),
+		Schema:            testSchema,
+		ConnectionTimeout: 10,
+		PartitionSize:     partitionSize,
+	})
+	if err != nil {
+		t.Fatalf("NewRepository: %v", err)
+	}
+	t.Cleanup(func() {
+		if _, err := repo.db.ExecContext(ctx, "DROP SCHEMA IF EXISTS "+testSchema+" CASCADE"); err != nil {
+			t.Errorf("Drop schema: %v", err)
+		}
+		if err := repo.Close(); err != nil {
+			t.Errorf("Close: %v", err)
+		}
+	})
+
+	if _, err = repo.db.ExecContext(ctx, "DROP SCHEMA IF EXISTS "+testSchema+" CASCADE"); err != nil {
+		t.Fatalf("Drop schema: %v", err)
+	}
+	if _, err = repo.MigrateUp(ctx); err != nil {
+		t.Fatalf("MigrateUp: %v", err)
+	}
+
+	tables := []string{blocksTable, evidencesTable, commitSignaturesTable, transactionsTable, accountTransactionsTable, rawTxsTable,
+		failedTxsTable, blockEventsTable, indexerStatusTable, prunedAccountTotalsTable, prunedShieldedTable, prunedFeesTable, ibcTransfersTable, maspTxsTable, maspAssetsTable}
+
+	repotest.Run(t, func(t *testing.T) repository.Repository {
+		if _, err := repo.db.ExecContext(ctx, "TRUNCATE "+strings.Join(tables, ", ")); err != nil {
+			t.Fatalf("Truncate tables: %v", err)
+		}
+		return testRepository{repo}
+	})
+}
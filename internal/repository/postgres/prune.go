@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// PruneBlocks deletes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+// Account txs counts, shielded amounts and fees of deleted txs are added to pruned aggregates before.
+func (p *postgres) PruneBlocks(ctx context.Context, fromHeight, toHeight int64, maspAddress string) error {
+	query, args, err := p.psql.Insert(prunedAccountTotalsTable).
+		Columns("address", "txs_count").
+		Select(p.psql.Select("address", "COUNT(DISTINCT tx_hash)").
+			From(accountTransactionsTable).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			GroupBy("address")).
+		Suffix("ON CONFLICT (address) DO UPDATE SET txs_count = " + prunedAccountTotalsTable + ".txs_count + EXCLUDED.txs_count").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks account totals")
+	}
+	if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks account totals")
+	}
+
+	// Same as shielded amounts calculated from transfers by the service, amounts which are not numbers are skipped
+	query, args, err = p.psql.Insert(prunedShieldedTable).
+		Columns("token", "amount").
+		Select(p.psql.Select("COALESCE(data ->> 'token', '')").
+			Column(sq.Expr("SUM(CASE WHEN data ->> 'target' = ? THEN (data ->> 'amount')::numeric ELSE -(data ->> 'amount')::numeric END)", maspAddress)).
+			From(transactionsTable).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			Where(sq.Eq{"tx_type": "Decrypted"}).
+			Where(sq.Expr("(data ->> 'source' = ?) <> (data ->> 'target' = ?)", maspAddress, maspAddress)).
+			Where("data ->> 'amount' ~ '^[0-9]+(\\.[0-9]+){0,1}$'").
+			GroupBy("COALESCE(data ->> 'token', '')")).
+		Suffix("ON CONFLICT (token) DO UPDATE SET amount = " + prunedShieldedTable + ".amount + EXCLUDED.amount").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks shielded")
+	}
+	if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks shielded")
+	}
+
+	// Same as fee stats calculated by GetFeeStats, grouped by day to keep stats by day
+	fees := p.psql.Select(
+		"date_trunc('day', b.header_time)",
+		"w.fee_payer",
+		"COALESCE(w.fee_token, '')",
+		"COUNT(*)",
+		"COALESCE(SUM(w.gas_limit_multiplier), 0)",
+		"COALESCE(SUM(COALESCE(d.gas_used, w.gas_used)), 0)",
+		"COALESCE(SUM(w.fee_paid), 0)",
+	).
+		From(transactionsTable+" w").
+		Join(blocksTable+" b USING (block_id)").
+		LeftJoin(transactionsTable+" d ON d.wrapper_id = w.hash AND d.block_height = w.block_height + 1").
+		Where("w.fee_paid IS NOT NULL").
+		Where(sq.GtOrEq{"w.block_height": fromHeight}).
+		Where(sq.LtOrEq{"w.block_height": toHeight}).
+		GroupBy("date_trunc('day', b.header_time)", "w.fee_payer", "COALESCE(w.fee_token, '')")
+
+	query, args, err = p.psql.Insert(prunedFeesTable).
+		Columns("day", "fee_payer", "fee_token", "txs_count", "gas_limit", "gas_used", "fee_paid").
+		Select(fees).
+		Suffix("ON CONFLICT (day, fee_payer, fee_token) DO UPDATE SET " +
+			"txs_count = " + prunedFeesTable + ".txs_count + EXCLUDED.txs_count, " +
+			"gas_limit = " + prunedFeesTable + ".gas_limit + EXCLUDED.gas_limit, " +
+			"gas_used = " + prunedFeesTable + ".gas_used + EXCLUDED.gas_used, " +
+			"fee_paid = " + prunedFeesTable + ".fee_paid + EXCLUDED.fee_paid").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks fees")
+	}
+	if _, err = p.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks fees")
+	}
+
+	return p.DeleteBlocks(ctx, fromHeight, toHeight)
+}
+
+func (p *postgres) GetPrunedShielded(ctx context.Context) ([]repository.TokenAmount, error) {
+	query, args, err := p.psql.Select("token", "amount").From(prunedShieldedTable).ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetPrunedShielded")
+	}
+
+	rows, err := p.reader().QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetPrunedShielded")
+	}
+	defer rows.Close()
+
+	var amounts []repository.TokenAmount
+	for rows.Next() {
+		var amount repository.TokenAmount
+		if err = rows.Scan(&amount.Token, &amount.Amount); err != nil {
+			return nil, errors.New(err, "Scan result for GetPrunedShielded")
+		}
+		amounts = append(amounts, amount)
+	}
+
+	return amounts, nil
+}
+
+// GetEarliestHeight returns the lowest stored height, 0 if there are no blocks.
+func (p *postgres) GetEarliestHeight(ctx context.Context) (int64, error) {
+	query, args, err := p.psql.Select("COALESCE(MIN(header_height), 0)").From(blocksTable).ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetEarliestHeight")
+	}
+
+	var height int64
+	err = p.reader().QueryRowContext(ctx, query, args...).Scan(&height)
+	return height, errors.New(err, "Exec SQL for GetEarliestHeight")
+}
+
+// GetFirstHeightSince returns the lowest height of blocks created at t or later.
+func (p *postgres) GetFirstHeightSince(ctx context.Context, t time.Time) (int64, error) {
+	query, args, err := p.psql.Select("MIN(header_height)").
+		From(blocksTable).
+		Where(sq.GtOrEq{"header_time": t}).
+		ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetFirstHeightSince")
+	}
+
+	var height sql.NullInt64
+	if err = p.exec.QueryRowContext(ctx, query, args...).Scan(&height); err != nil {
+		return 0, errors.New(err, "Exec SQL for GetFirstHeightSince")
+	}
+	if !height.Valid {
+		return 0, repository.ErrNotFound
+	}
+
+	return height.Int64, nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (p *postgres) AddRawTxs(ctx context.Context, txs ...repository.RawTx) error {
+	rows := make([][]any, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []any{tx.TxHash, tx.BlockID, tx.BlockHeight, tx.TxPos, tx.Data})
+	}
+
+	return p.insertRows(ctx, "AddRawTxs", rawTxsTable, []string{"tx_hash", "block_id", "block_height", "tx_pos", "data"}, rows)
+}
+
+func (p *postgres) GetRawTxs(ctx context.Context, fromHeight, toHeight int64) ([]repository.RawTx, error) {
+	query, args, err := p.psql.Select("tx_hash", "block_id", "block_height", "tx_pos", "data").
+		From(rawTxsTable).
+		Where(sq.GtOrEq{"block_height": fromHeight}).
+		Where(sq.LtOrEq{"block_height": toHeight}).
+		OrderBy("block_height", "tx_pos").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetRawTxs")
+	}
+
+	rows, err := p.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetRawTxs")
+	}
+	defer rows.Close()
+
+	var txs []repository.RawTx
+	for rows.Next() {
+		var tx repository.RawTx
+		if err = rows.Scan(&tx.TxHash, &tx.BlockID, &tx.BlockHeight, &tx.TxPos, &tx.Data); err != nil {
+			return nil, errors.New(err, "Scan result for GetRawTxs")
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
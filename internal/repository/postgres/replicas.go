@@ -0,0 +1,192 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+// replicaCheckInterval is how often replicas availability and lag are checked.
+const replicaCheckInterval = 5 * time.Second
+
+type replica struct {
+	name      string
+	db        *sql.DB
+	available atomic.Bool
+}
+
+// replicas routes read queries to available replicas in turn, it is shared by all runners of the repository.
+type replicas struct {
+	list   []*replica
+	maxLag time.Duration
+	next   atomic.Uint64
+
+	stop context.CancelFunc
+	done sync.WaitGroup
+}
+
+func openDB(config repository.Config) (*sql.DB, error) {
+	db, err := sql.Open("postgres", fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=disable&connect_timeout=%d",
+		config.User, config.Password, config.Host, config.Port, config.DbName, config.ConnectionTimeout))
+	return db, errors.New(err, "Open sql connection")
+}
+
+// openReplicas connects to replicas and starts checking them in background. Unreachable replicas
+// don't fail the start, they are queried once they are back.
+func openReplicas(ctx context.Context, config repository.Config) *replicas {
+	r := &replicas{maxLag: time.Duration(config.MaxReplicaLag) * time.Second}
+
+	for _, replicaCfg := range config.Replicas {
+		cfg := config
+		if replicaCfg.Host != "" {
+			cfg.Host = replicaCfg.Host
+		}
+		if replicaCfg.Port != "" {
+			cfg.Port = replicaCfg.Port
+		}
+		if replicaCfg.User != "" {
+			cfg.User = replicaCfg.User
+		}
+		if replicaCfg.Password != "" {
+			cfg.Password = replicaCfg.Password
+		}
+		if replicaCfg.DbName != "" {
+			cfg.DbName = replicaCfg.DbName
+		}
+
+		// Opening doesn't connect, the connection is checked with the replica status
+		db, err := openDB(cfg)
+		if err != nil {
+			logger.Error("Failed to open replica connection", zap.String("replica", cfg.Host+":"+cfg.Port), zap.Error(err))
+			continue
+		}
+
+		r.list = append(r.list, &replica{name: cfg.Host + ":" + cfg.Port, db: db})
+	}
+
+	r.check(ctx)
+
+	checkCtx, stop := context.WithCancel(context.Background())
+	r.stop = stop
+	r.done.Add(1)
+	go func() {
+		defer r.done.Done()
+
+		ticker := time.NewTicker(replicaCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-checkCtx.Done():
+				return
+			case <-ticker.C:
+				r.check(checkCtx)
+			}
+		}
+	}()
+
+	return r
+}
+
+// check updates availability of all replicas.
+func (r *replicas) check(ctx context.Context) []repository.ReplicaStatus {
+	statuses := make([]repository.ReplicaStatus, 0, len(r.list))
+	for _, replica := range r.list {
+		status, err := replica.status(ctx)
+		if err != nil {
+			logger.Warn("Replica is unavailable", zap.String("replica", replica.name), zap.Error(err))
+		}
+		if err == nil && r.maxLag > 0 && status.Lag > r.maxLag {
+			status.Available = false
+		}
+
+		if replica.available.Swap(status.Available) != status.Available {
+			logger.Info("Replica availability changed", zap.String("replica", replica.name), zap.Bool("available", status.Available),
+				zap.Duration("lag", status.Lag))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// status returns the replication lag and the last block height of the replica. The lag is the time
+// since the last replayed transaction while the replica hasn't replayed everything it has received.
+func (r *replica) status(ctx context.Context) (repository.ReplicaStatus, error) {
+	status := repository.ReplicaStatus{Name: r.name}
+
+	var lag float64
+	err := r.db.QueryRowContext(ctx, `SELECT CASE WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+		ELSE COALESCE(EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp()), 0) END`).Scan(&lag)
+	if err != nil {
+		return status, errors.New(err, "Get replication lag")
+	}
+	status.Lag = time.Duration(lag * float64(time.Second))
+
+	err = r.db.QueryRowContext(ctx, "SELECT COALESCE(MAX(header_height), 0) FROM "+blocksTable).Scan(&status.LastHeight)
+	if err != nil {
+		return status, errors.New(err, "Get replica last height")
+	}
+
+	status.Available = true
+
+	return status, nil
+}
+
+// pick returns the next available replica, nil if there are none.
+func (r *replicas) pick() *sql.DB {
+	if r == nil {
+		return nil
+	}
+	for range r.list {
+		replica := r.list[r.next.Add(1)%uint64(len(r.list))]
+		if replica.available.Load() {
+			return replica.db
+		}
+	}
+	return nil
+}
+
+func (r *replicas) close() error {
+	if r == nil {
+		return nil
+	}
+
+	r.stop()
+	r.done.Wait()
+
+	var err error
+	for _, replica := range r.list {
+		if closeErr := replica.db.Close(); closeErr != nil {
+			err = errors.New(closeErr, "Close replica "+replica.name)
+		}
+	}
+	return err
+}
+
+// reader returns the executor for read queries of the API: an available replica, the primary
+// if there are none, or the database transaction.
+func (p *postgres) reader() executor {
+	if p.db == nil {
+		return p.exec
+	}
+	if db := p.replicas.pick(); db != nil {
+		return db
+	}
+	return p.exec
+}
+
+func (p *postgres) GetReplicasStatus(ctx context.Context) ([]repository.ReplicaStatus, error) {
+	if p.replicas == nil {
+		return nil, nil
+	}
+	return p.replicas.check(ctx), nil
+}
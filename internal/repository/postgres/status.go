@@ -0,0 +1,54 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (p *postgres) SaveIndexerStatus(ctx context.Context, status repository.IndexerStatus) error {
+	query, args, err := p.psql.Insert(indexerStatusTable).
+		Columns("id", "indexed_height", "chain_height", "last_block_time", "sync_state", "version", "checksums", "error_count", "updated_at").
+		Values(1, status.IndexedHeight, status.ChainHeight, status.LastBlockTime, status.SyncState, status.Version, status.Checksums, status.ErrorCount, status.UpdatedAt).
+		Suffix(`ON CONFLICT (id) DO UPDATE SET
+			indexed_height = EXCLUDED.indexed_height,
+			chain_height = EXCLUDED.chain_height,
+			last_block_time = EXCLUDED.last_block_time,
+			sync_state = EXCLUDED.sync_state,
+			version = EXCLUDED.version,
+			checksums = EXCLUDED.checksums,
+			error_count = EXCLUDED.error_count,
+			updated_at = EXCLUDED.updated_at`).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for SaveIndexerStatus")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for SaveIndexerStatus")
+}
+
+func (p *postgres) GetIndexerStatus(ctx context.Context) (repository.IndexerStatus, error) {
+	query, args, err := p.psql.Select("indexed_height", "chain_height", "last_block_time", "sync_state", "version", "checksums", "error_count", "updated_at").
+		From(indexerStatusTable).
+		ToSql()
+	if err != nil {
+		return repository.IndexerStatus{}, errors.New(err, "Build SQL for GetIndexerStatus")
+	}
+
+	var status repository.IndexerStatus
+	err = p.exec.QueryRowContext(ctx, query, args...).Scan(&status.IndexedHeight, &status.ChainHeight, &status.LastBlockTime,
+		&status.SyncState, &status.Version, &status.Checksums, &status.ErrorCount, &status.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return status, repository.ErrNotFound
+	}
+
+	return status, errors.New(err, "Exec SQL for GetIndexerStatus")
+}
+
+func (p *postgres) Ping(ctx context.Context) error {
+	var one int
+	return errors.New(p.exec.QueryRowContext(ctx, "SELECT 1").Scan(&one), "Ping database")
+}
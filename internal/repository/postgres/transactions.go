@@ -10,24 +10,12 @@ import (
 )
 
 func (p *postgres) AddTransactions(ctx context.Context, txs ...repository.Transaction) error {
-	if len(txs) == 0 {
-		return nil
-	}
-
-	builder := p.psql.Insert(transactionsTable).
-		Columns("hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block")
-
+	rows := make([][]any, 0, len(txs))
 	for _, tx := range txs {
-		builder = builder.Values(tx.Hash, tx.BlockID, tx.TxType, tx.WrapperID, tx.Memo, tx.FeeAmountPerGasUnit, tx.FeeToken, tx.GasLimitMultiplier, tx.Code, tx.Data, tx.ReturnCode, tx.PosInBlock)
+		rows = append(rows, []any{tx.Hash, tx.BlockID, tx.TxType, tx.WrapperID, tx.Memo, tx.FeeAmountPerGasUnit, tx.FeeToken, tx.GasLimitMultiplier, tx.Code, jsonValue(tx.Data), tx.ReturnCode, tx.PosInBlock, tx.GasUsed, tx.FeePaid, tx.BlockHeight, tx.FeePayer})
 	}
 
-	query, args, err := builder.ToSql()
-	if err != nil {
-		return errors.New(err, "Build SQL for AddTransactions")
-	}
-
-	_, err = p.exec.ExecContext(ctx, query, args...)
-	return errors.New(err, "Exec SQL for AddTransactions")
+	return p.insertRows(ctx, "AddTransactions", transactionsTable, []string{"hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block", "gas_used", "fee_paid", "block_height", "fee_payer"}, rows)
 }
 
 func (p *postgres) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter) (uint64, error) {
@@ -39,6 +27,9 @@ func (p *postgres) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter
 	if len(filter.BlockID) != 0 {
 		builder = builder.Where(sq.Eq{"block_id": filter.BlockID})
 	}
+	if filter.Height != 0 {
+		builder = builder.Where(sq.Eq{"block_height": filter.Height})
+	}
 	if filter.Memo != "" {
 		builder = builder.Where(sq.Eq{"memo": filter.Memo})
 	}
@@ -56,7 +47,7 @@ func (p *postgres) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter
 	}
 
 	var total uint64
-	err = p.exec.QueryRowContext(ctx, query, args...).Scan(&total)
+	err = p.reader().QueryRowContext(ctx, query, args...).Scan(&total)
 	if err != nil {
 		return 0, errors.New(err, "Exec SQL for GetTotalTxsBy")
 	}
@@ -65,7 +56,7 @@ func (p *postgres) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter
 }
 
 func (p *postgres) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]repository.Transaction, error) {
-	builder := p.psql.Select("hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block", "header_height", "header_time").
+	builder := p.psql.Select("hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block", "gas_used", "fee_paid", "fee_payer", "header_height", "header_time").
 		From(transactionsTable).
 		Join(blocksTable + " USING (block_id)")
 
@@ -75,6 +66,9 @@ func (p *postgres) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]
 	if len(filter.BlockID) != 0 {
 		builder = builder.Where(sq.Eq{"block_id": filter.BlockID})
 	}
+	if filter.Height != 0 {
+		builder = builder.Where(sq.Eq{"block_height": filter.Height})
+	}
 	if filter.Memo != "" {
 		builder = builder.Where(sq.Eq{"memo": filter.Memo})
 	}
@@ -93,7 +87,7 @@ func (p *postgres) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]
 		return nil, errors.New(err, "Build SQL for GetTxsBy")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetTxsBy: "+query)
 	}
@@ -104,7 +98,7 @@ func (p *postgres) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]
 		var tx repository.Transaction
 		if err = rows.Scan(&tx.Hash, &tx.BlockID, &tx.TxType, &tx.WrapperID, &tx.Memo,
 			&tx.FeeAmountPerGasUnit, &tx.FeeToken, &tx.GasLimitMultiplier, &tx.Code, &tx.Data, &tx.ReturnCode, &tx.PosInBlock,
-			&tx.BlockHeight, &tx.BlockTime); err != nil {
+			&tx.GasUsed, &tx.FeePaid, &tx.FeePayer, &tx.BlockHeight, &tx.BlockTime); err != nil {
 			return nil, errors.New(err, "Scan result for GetTxsBy")
 		}
 		txs = append(txs, tx)
@@ -118,12 +112,15 @@ func (p *postgres) GetTxsBySourceOrTarget(ctx context.Context, address string) (
 		From(transactionsTable).
 		Where(sq.Eq{"tx_type": "Decrypted"}).
 		Where(sq.Or{sq.Eq{"data ->> 'source'": address}, sq.Eq{"data ->> 'target'": address}}).
+		// Heights of account transactions let partitions of other heights be skipped
+		Where(sq.Expr("(hash, block_height) IN (SELECT tx_hash, block_height FROM "+accountTransactionsTable+" WHERE address = ? AND role IN (?, ?))",
+			[]byte(address), repository.RoleSource, repository.RoleTarget)).
 		ToSql()
 	if err != nil {
 		return nil, errors.New(err, "Build SQL for GetTxsBySourceOrTarget")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetTxsBySourceOrTarget")
 	}
@@ -142,11 +139,11 @@ func (p *postgres) GetTxsBySourceOrTarget(ctx context.Context, address string) (
 	return txs, nil
 }
 
-func (p *postgres) GetVoteProposalDatas(ctx context.Context, voteCode []byte, proposalID int64) ([]json.RawMessage, error) {
+func (p *postgres) GetVoteProposalDatas(ctx context.Context, voteCodes [][]byte, proposalID int64) ([]json.RawMessage, error) {
 	query, args, err := p.psql.Select("data").
 		From(transactionsTable).
 		Join(blocksTable+" USING (block_id)").
-		Where(sq.Eq{"code": voteCode}).
+		Where(sq.Eq{"code": voteCodes}).
 		Where(sq.Eq{"(data->>'id')::int": proposalID}).
 		OrderBy("header_height DESC", "pos_in_block DESC").
 		ToSql()
@@ -154,7 +151,7 @@ func (p *postgres) GetVoteProposalDatas(ctx context.Context, voteCode []byte, pr
 		return nil, errors.New(err, "Build SQL for GetVoteProposalDatas")
 	}
 
-	rows, err := p.exec.QueryContext(ctx, query, args...)
+	rows, err := p.reader().QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, errors.New(err, "Exec SQL for GetVoteProposalDatas")
 	}
@@ -171,3 +168,60 @@ func (p *postgres) GetVoteProposalDatas(ctx context.Context, voteCode []byte, pr
 
 	return datas, nil
 }
+
+func (p *postgres) GetHeightsWithoutWrapperIDs(ctx context.Context) ([]int64, error) {
+	query, args, err := p.psql.Select("DISTINCT block_height").
+		From(transactionsTable).
+		Where(sq.Eq{"tx_type": "Decrypted"}).
+		Where(sq.Eq{"wrapper_id": nil}).
+		OrderBy("block_height").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetHeightsWithoutWrapperIDs")
+	}
+
+	rows, err := p.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetHeightsWithoutWrapperIDs")
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err = rows.Scan(&height); err != nil {
+			return nil, errors.New(err, "Scan result for GetHeightsWithoutWrapperIDs")
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, nil
+}
+
+func (p *postgres) UpdateWrapperID(ctx context.Context, blockHeight int64, txHash, wrapperID []byte) error {
+	query, args, err := p.psql.Update(transactionsTable).
+		Set("wrapper_id", wrapperID).
+		Where(sq.Eq{"block_height": blockHeight}).
+		Where(sq.Eq{"hash": txHash}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for UpdateWrapperID")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for UpdateWrapperID")
+}
+
+func (p *postgres) UpdateTxData(ctx context.Context, blockHeight int64, txHash []byte, data []byte) error {
+	query, args, err := p.psql.Update(transactionsTable).
+		Set("data", data).
+		Where(sq.Eq{"block_height": blockHeight}).
+		Where(sq.Eq{"hash": txHash}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for UpdateTxData")
+	}
+
+	_, err = p.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for UpdateTxData")
+}
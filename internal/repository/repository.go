@@ -3,40 +3,85 @@ package repository
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/the-laziest/namadexer-go/pkg/errors"
 )
 
 type Repository interface {
-	CreateTables(ctx context.Context) error
-
 	AddBlock(ctx context.Context, block Block) error
 	GetBlockBy(ctx context.Context, filter BlockFilter) (Block, error)
 	GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*Block, error)
+	DeleteBlocks(ctx context.Context, fromHeight, toHeight int64) error
 
 	AddTransactions(ctx context.Context, txs ...Transaction) error
 	GetTotalTxsBy(ctx context.Context, filter TxFilter) (uint64, error)
 	GetTxsBy(ctx context.Context, filter TxFilter) ([]Transaction, error)
+	// GetTxsBySourceOrTarget returns decrypted txs with the address as source or target of their data. Txs are found
+	// by account transactions of the address with source or target role.
 	GetTxsBySourceOrTarget(ctx context.Context, address string) ([]Transaction, error)
-	GetVoteProposalDatas(ctx context.Context, voteCode []byte, proposalID int64) ([]json.RawMessage, error)
+	GetVoteProposalDatas(ctx context.Context, voteCodes [][]byte, proposalID int64) ([]json.RawMessage, error)
+	GetHeightsWithoutWrapperIDs(ctx context.Context) ([]int64, error)
+	UpdateWrapperID(ctx context.Context, blockHeight int64, txHash, wrapperID []byte) error
+	UpdateTxData(ctx context.Context, blockHeight int64, txHash []byte, data []byte) error
+	GetFeeStats(ctx context.Context, filter FeeStatsFilter) ([]FeeStats, error)
+
+	AddRawTxs(ctx context.Context, txs ...RawTx) error
+	GetRawTxs(ctx context.Context, fromHeight, toHeight int64) ([]RawTx, error)
+
+	AddFailedTxs(ctx context.Context, txs ...FailedTx) error
+	GetFailedTxs(ctx context.Context, limit, offset uint64) ([]FailedTx, error)
+	GetFailedTxHeights(ctx context.Context) ([]int64, error)
+	// GetBlockFailedTxs returns failed txs of the block at the height ordered by position in block.
+	GetBlockFailedTxs(ctx context.Context, height int64) ([]FailedTx, error)
+	DeleteFailedTx(ctx context.Context, height, txPos int64) error
 
 	AddAccountTransactions(ctx context.Context, txs ...AccountTransaction) error
-	GetTotalAccountTxs(ctx context.Context, address []byte) (uint64, error)
-	GetAccountTxs(ctx context.Context, address []byte, limit, offset uint64) ([][]byte, error)
+	GetTotalAccountTxs(ctx context.Context, address []byte, role string) (uint64, error)
+	GetAccountTxs(ctx context.Context, address []byte, role string, limit, offset uint64) ([][]byte, error)
+	DeleteAccountTransactions(ctx context.Context, txHash []byte) error
+
+	AddIbcTransfers(ctx context.Context, transfers ...IbcTransfer) error
+	GetIbcTransfers(ctx context.Context, filter IbcTransferFilter) ([]IbcTransfer, error)
+	DeleteIbcTransfers(ctx context.Context, txHash []byte) error
+
+	AddMaspTxs(ctx context.Context, txs ...MaspTx) error
+	GetMaspTxs(ctx context.Context, txHash []byte) ([]MaspTx, error)
+	DeleteMaspTxs(ctx context.Context, txHash []byte) error
 
-	GetAccountThresholds(ctx context.Context, updateAccountCode []byte, accountID string) ([]*uint8, error)
-	GetAccountVPCodes(ctx context.Context, updateAccountCode []byte, accountID string) ([]*string, error)
-	GetAccountPublicKeys(ctx context.Context, updateAccountCode []byte, accountID string) ([][]string, error)
+	// AddMaspAssets skips asset types which are already saved
+	AddMaspAssets(ctx context.Context, assets ...MaspAsset) error
+	// GetMaspAssets returns all assets if no asset types are passed
+	GetMaspAssets(ctx context.Context, assetTypes ...[]byte) ([]MaspAsset, error)
+
+	GetAccountThresholds(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*uint8, error)
+	GetAccountVPCodes(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*string, error)
+	GetAccountPublicKeys(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([][]string, error)
 
 	AddCommitSignatures(ctx context.Context, signatures ...CommitSignature) error
 	GetCommitsCount(ctx context.Context, validatorAddress []byte, start, end int64) (int64, error)
 
 	AddEvidences(ctx context.Context, evidences ...Evidence) error
 
+	AddBlockEvents(ctx context.Context, events ...BlockEvent) error
+	GetBlockEvents(ctx context.Context, filter EventFilter) ([]BlockEvent, error)
+
 	GetLastHeight(ctx context.Context) (int64, error)
+	GetEarliestHeight(ctx context.Context) (int64, error)
+	GetFirstHeightSince(ctx context.Context, t time.Time) (int64, error)
+
+	PruneBlocks(ctx context.Context, fromHeight, toHeight int64, maspAddress string) error
+	GetPrunedShielded(ctx context.Context) ([]TokenAmount, error)
+
+	// EnsurePartitions prepares partitioned tables for rows with heights up to height.
+	EnsurePartitions(ctx context.Context, height int64) error
+
+	SaveIndexerStatus(ctx context.Context, status IndexerStatus) error
+	GetIndexerStatus(ctx context.Context) (IndexerStatus, error)
 
-	HasIndexes(ctx context.Context) (bool, error)
-	CreateIndexes(ctx context.Context) error
+	Ping(ctx context.Context) error
+	// GetReplicasStatus checks read replicas, there are none if the backend doesn't support them.
+	GetReplicasStatus(ctx context.Context) ([]ReplicaStatus, error)
 
 	RunInTransaction(ctx context.Context, txFunc InTransaction) error
 
@@ -0,0 +1,1159 @@
+// Package repotest is a conformance suite every repository.Repository implementation must pass.
+package repotest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+// Factory returns a new empty repository with applied schema, the suite closes it after the test.
+type Factory func(t *testing.T) repository.Repository
+
+const maspAddress = "tnam1pcqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzmefah"
+
+var (
+	voteCode   = []byte("vote_proposal_code")
+	updateCode = []byte("update_account_code")
+	genesis    = time.Date(2024, 3, 1, 23, 57, 0, 0, time.UTC)
+)
+
+// Run runs every test of the suite against a fresh repository.
+func Run(t *testing.T, newRepo Factory) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, repo repository.Repository)
+	}{
+		{"Blocks", testBlocks},
+		{"DeleteBlocks", testDeleteBlocks},
+		{"Transactions", testTransactions},
+		{"VoteProposals", testVoteProposals},
+		{"AccountUpdates", testAccountUpdates},
+		{"SourceOrTarget", testSourceOrTarget},
+		{"AccountTxs", testAccountTxs},
+		{"CommitSignatures", testCommitSignatures},
+		{"Events", testEvents},
+		{"FailedTxs", testFailedTxs},
+		{"RawTxs", testRawTxs},
+		{"IbcTransfers", testIbcTransfers},
+		{"MaspTxs", testMaspTxs},
+		{"MaspAssets", testMaspAssets},
+		{"FeeStats", testFeeStats},
+		{"IndexerStatus", testIndexerStatus},
+		{"Prune", testPrune},
+		{"PruneFees", testPruneFees},
+		{"Partitions", testPartitions},
+		{"TransactionCommit", testTransactionCommit},
+		{"TransactionRollback", testTransactionRollback},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newRepo(t)
+			t.Cleanup(func() {
+				if err := repo.Close(); err != nil {
+					t.Errorf("Close: %v", err)
+				}
+			})
+			tt.test(t, repo)
+		})
+	}
+}
+
+func blockID(height int64) []byte {
+	return []byte("block-" + strconv.FormatInt(height, 10))
+}
+
+func txHash(height, pos int64) []byte {
+	return []byte("tx-" + strconv.FormatInt(height, 10) + "-" + strconv.FormatInt(pos, 10))
+}
+
+func newBlock(height int64) repository.Block {
+	return repository.Block{
+		BlockID:                           blockID(height),
+		HeaderVersionApp:                  1,
+		HeaderVersionBlock:                11,
+		HeaderChainID:                     "test-chain",
+		HeaderHeight:                      height,
+		HeaderTime:                        genesis.Add(time.Duration(height) * time.Minute),
+		HeaderLastBlockIDHash:             blockID(height - 1),
+		HeaderLastBlockIDPartsHeaderTotal: 1,
+		HeaderLastBlockIDPartsHeaderHash:  []byte("parts"),
+		HeaderLastCommitHash:              []byte("commit"),
+		HeaderDataHash:                    []byte("data"),
+		HeaderValidatorsHash:              []byte("validators"),
+		HeaderNextValidatorsHash:          []byte("next-validators"),
+		HeaderConsensusHash:               []byte("consensus"),
+		HeaderAppHash:                     []byte("app"),
+		HeaderLastResultsHash:             []byte("results"),
+		HeaderEvidenceHash:                []byte("evidence"),
+		HeaderProposerAddress:             []byte("proposer"),
+		CommitHeight:                      height - 1,
+		CommitRound:                       0,
+		CommitBlockIDHash:                 blockID(height - 1),
+		CommitBlockIDPartsHeaderTotal:     1,
+		CommitBlockIDPartsHeaderHash:      []byte("parts"),
+	}
+}
+
+func newTx(height, pos int64, txType string, code []byte, data string) repository.Transaction {
+	tx := repository.Transaction{
+		Hash:        txHash(height, pos),
+		BlockID:     blockID(height),
+		TxType:      txType,
+		Code:        code,
+		PosInBlock:  pos,
+		BlockHeight: height,
+	}
+	if data != "" {
+		tx.Data = []byte(data)
+	}
+	return tx
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func addBlocks(t *testing.T, repo repository.Repository, heights ...int64) {
+	t.Helper()
+	for _, height := range heights {
+		if err := repo.AddBlock(context.Background(), newBlock(height)); err != nil {
+			t.Fatalf("AddBlock %d: %v", height, err)
+		}
+	}
+}
+
+func addTxs(t *testing.T, repo repository.Repository, txs ...repository.Transaction) {
+	t.Helper()
+	if err := repo.AddTransactions(context.Background(), txs...); err != nil {
+		t.Fatalf("AddTransactions: %v", err)
+	}
+}
+
+// addTransferAccountTxs adds account txs of sources and targets of transfer txs like the indexer does.
+func addTransferAccountTxs(t *testing.T, repo repository.Repository, txs ...repository.Transaction) {
+	t.Helper()
+	var accountTxs []repository.AccountTransaction
+	for _, tx := range txs {
+		var transfer struct {
+			Source string `json:"source"`
+			Target string `json:"target"`
+		}
+		if err := json.Unmarshal(tx.Data, &transfer); err != nil {
+			continue
+		}
+		for _, accTx := range []repository.AccountTransaction{
+			{Address: transfer.Source, Role: repository.RoleSource},
+			{Address: transfer.Target, Role: repository.RoleTarget},
+		} {
+			if accTx.Address != "" {
+				accTx.TxHash, accTx.BlockHeight, accTx.TxPos = tx.Hash, tx.BlockHeight, tx.PosInBlock
+				accountTxs = append(accountTxs, accTx)
+			}
+		}
+	}
+	if err := repo.AddAccountTransactions(context.Background(), accountTxs...); err != nil {
+		t.Fatalf("AddAccountTransactions: %v", err)
+	}
+}
+
+func checkBlock(t *testing.T, got, want repository.Block) {
+	t.Helper()
+	if !got.HeaderTime.Equal(want.HeaderTime) {
+		t.Errorf("block %d time = %v, want %v", want.HeaderHeight, got.HeaderTime, want.HeaderTime)
+	}
+	got.HeaderTime, want.HeaderTime = time.Time{}, time.Time{}
+	if !jsonEqual(got, want) {
+		t.Errorf("block = %+v, want %+v", got, want)
+	}
+}
+
+// jsonEqual compares values ignoring the difference between nil and empty slices.
+func jsonEqual(a, b any) bool {
+	aJSON, _ := json.Marshal(a)
+	bJSON, _ := json.Marshal(b)
+	return bytes.Equal(aJSON, bJSON)
+}
+
+func hashes(txs []repository.Transaction) []string {
+	result := make([]string, 0, len(txs))
+	for _, tx := range txs {
+		result = append(result, string(tx.Hash))
+	}
+	sort.Strings(result)
+	return result
+}
+
+func checkStrings(t *testing.T, name string, got, want []string) {
+	t.Helper()
+	if !jsonEqual(got, want) {
+		t.Errorf("%s = %q, want %q", name, got, want)
+	}
+}
+
+func checkFloat(t *testing.T, name, got string, want float64) {
+	t.Helper()
+	value, err := strconv.ParseFloat(got, 64)
+	if err != nil || value != want {
+		t.Errorf("%s = %q, want %v", name, got, want)
+	}
+}
+
+func testBlocks(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	height, err := repo.GetLastHeight(ctx)
+	if err != nil || height != 0 {
+		t.Fatalf("GetLastHeight of empty repository = %d, %v", height, err)
+	}
+	if _, err = repo.GetBlockBy(ctx, repository.BlockFilter{Height: 1}); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetBlockBy missing block error = %v, want ErrNotFound", err)
+	}
+
+	addBlocks(t, repo, 1, 2, 3)
+
+	if height, err = repo.GetLastHeight(ctx); err != nil || height != 3 {
+		t.Fatalf("GetLastHeight = %d, %v, want 3", height, err)
+	}
+	if height, err = repo.GetEarliestHeight(ctx); err != nil || height != 1 {
+		t.Fatalf("GetEarliestHeight = %d, %v, want 1", height, err)
+	}
+
+	block, err := repo.GetBlockBy(ctx, repository.BlockFilter{Height: 2})
+	if err != nil {
+		t.Fatalf("GetBlockBy height: %v", err)
+	}
+	checkBlock(t, block, newBlock(2))
+
+	block, err = repo.GetBlockBy(ctx, repository.BlockFilter{BlockID: blockID(3)})
+	if err != nil {
+		t.Fatalf("GetBlockBy block id: %v", err)
+	}
+	checkBlock(t, block, newBlock(3))
+
+	blocks, err := repo.GetLatestBlocks(ctx, 2, 0)
+	if err != nil {
+		t.Fatalf("GetLatestBlocks: %v", err)
+	}
+	if len(blocks) != 2 || blocks[0].HeaderHeight != 3 || blocks[1].HeaderHeight != 2 {
+		t.Fatalf("GetLatestBlocks returned %d blocks, want heights 3 and 2", len(blocks))
+	}
+
+	blocks, err = repo.GetLatestBlocks(ctx, 0, 2)
+	if err != nil {
+		t.Fatalf("GetLatestBlocks with offset: %v", err)
+	}
+	if len(blocks) != 1 || blocks[0].HeaderHeight != 1 {
+		t.Fatalf("GetLatestBlocks with offset returned %d blocks, want height 1", len(blocks))
+	}
+
+	if height, err = repo.GetFirstHeightSince(ctx, newBlock(2).HeaderTime.Add(-time.Second)); err != nil || height != 2 {
+		t.Fatalf("GetFirstHeightSince = %d, %v, want 2", height, err)
+	}
+	if _, err = repo.GetFirstHeightSince(ctx, newBlock(4).HeaderTime); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetFirstHeightSince after last block error = %v, want ErrNotFound", err)
+	}
+
+	if _, err = repo.GetBlockBy(ctx, repository.BlockFilter{Height: 2, BlockID: blockID(3)}); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetBlockBy with mismatching filter error = %v, want ErrNotFound", err)
+	}
+
+	if err = repo.AddBlock(ctx, newBlock(2)); err == nil {
+		t.Fatalf("AddBlock with existing block id succeeded")
+	}
+}
+
+func testDeleteBlocks(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addBlocks(t, repo, 1, 2, 3)
+	addTxs(t, repo, newTx(1, 0, "Wrapper", nil, ""), newTx(2, 0, "Wrapper", nil, ""), newTx(3, 0, "Wrapper", nil, ""))
+	if err := repo.AddEvidences(ctx, repository.Evidence{BlockID: blockID(2), Height: 1, Time: 1, Address: []byte("validator"), TotalVotingPower: 10, ValidatorPower: 1}); err != nil {
+		t.Fatalf("AddEvidences: %v", err)
+	}
+	if err := repo.AddCommitSignatures(ctx, repository.CommitSignature{BlockID: blockID(2), BlockHeight: 2, BlockIDFlag: 2, ValidatorAddress: []byte("validator"), Timestamp: 1, Signature: []byte("sig")}); err != nil {
+		t.Fatalf("AddCommitSignatures: %v", err)
+	}
+
+	if err := repo.DeleteBlocks(ctx, 2, 3); err != nil {
+		t.Fatalf("DeleteBlocks: %v", err)
+	}
+
+	if height, err := repo.GetLastHeight(ctx); err != nil || height != 1 {
+		t.Fatalf("GetLastHeight after delete = %d, %v, want 1", height, err)
+	}
+	txs, err := repo.GetTxsBy(ctx, repository.TxFilter{})
+	if err != nil {
+		t.Fatalf("GetTxsBy: %v", err)
+	}
+	checkStrings(t, "txs after delete", hashes(txs), []string{"tx-1-0"})
+
+	cnt, err := repo.GetCommitsCount(ctx, []byte("validator"), 0, 10)
+	if err != nil || cnt != 0 {
+		t.Fatalf("GetCommitsCount after delete = %d, %v, want 0", cnt, err)
+	}
+
+	// Heights are free again
+	addBlocks(t, repo, 2)
+}
+
+func testTransactions(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addBlocks(t, repo, 1, 2)
+
+	wrapper := newTx(1, 0, "Wrapper", nil, "")
+	wrapper.Memo = ptr("hello")
+	wrapper.FeeAmountPerGasUnit = "0.5"
+	wrapper.FeeToken = "nam"
+	wrapper.GasLimitMultiplier = ptr(uint64(20000))
+	wrapper.GasUsed = ptr(uint64(100))
+	wrapper.FeePaid = ptr("10000")
+	wrapper.FeePayer = "payer"
+
+	decrypted := newTx(2, 0, "Decrypted", []byte("code"), `{"source":"a","target":"b"}`)
+	decrypted.WrapperID = wrapper.Hash
+	decrypted.ReturnCode = ptr(int64(0))
+	decrypted.GasUsed = ptr(uint64(80))
+
+	addTxs(t, repo, wrapper, decrypted, newTx(2, 1, "Decrypted", nil, ""))
+
+	txs, err := repo.GetTxsBy(ctx, repository.TxFilter{Hashes: [][]byte{wrapper.Hash}})
+	if err != nil || len(txs) != 1 {
+		t.Fatalf("GetTxsBy hash = %d txs, %v", len(txs), err)
+	}
+	got := txs[0]
+	if !got.BlockTime.Equal(newBlock(1).HeaderTime) {
+		t.Errorf("tx block time = %v, want %v", got.BlockTime, newBlock(1).HeaderTime)
+	}
+	got.BlockTime = time.Time{}
+	if !jsonEqual(got, wrapper) {
+		t.Errorf("tx = %+v, want %+v", got, wrapper)
+	}
+
+	txs, err = repo.GetTxsBy(ctx, repository.TxFilter{Hashes: [][]byte{decrypted.Hash}})
+	if err != nil || len(txs) != 1 {
+		t.Fatalf("GetTxsBy hash = %d txs, %v", len(txs), err)
+	}
+	var data, wantData any
+	if err = json.Unmarshal(txs[0].Data, &data); err != nil {
+		t.Fatalf("Unmarshal tx data: %v", err)
+	}
+	_ = json.Unmarshal(decrypted.Data, &wantData)
+	if !jsonEqual(data, wantData) || !bytes.Equal(txs[0].WrapperID, wrapper.Hash) || *txs[0].ReturnCode != 0 {
+		t.Errorf("decrypted tx = %+v, want %+v", txs[0], decrypted)
+	}
+
+	filters := []struct {
+		filter repository.TxFilter
+		want   []string
+	}{
+		{repository.TxFilter{}, []string{"tx-1-0", "tx-2-0", "tx-2-1"}},
+		{repository.TxFilter{BlockID: blockID(2)}, []string{"tx-2-0", "tx-2-1"}},
+		{repository.TxFilter{Height: 1}, []string{"tx-1-0"}},
+		{repository.TxFilter{Memo: "hello"}, []string{"tx-1-0"}},
+		{repository.TxFilter{TxType: "Decrypted"}, []string{"tx-2-0", "tx-2-1"}},
+		{repository.TxFilter{TxType: "Decrypted", Height: 1}, []string{}},
+		{repository.TxFilter{Hashes: [][]byte{txHash(1, 0), txHash(2, 1)}, Height: 2}, []string{"tx-2-1"}},
+	}
+	for _, f := range filters {
+		txs, err = repo.GetTxsBy(ctx, f.filter)
+		if err != nil {
+			t.Fatalf("GetTxsBy %+v: %v", f.filter, err)
+		}
+		checkStrings(t, "GetTxsBy", hashes(txs), f.want)
+
+		total, err := repo.GetTotalTxsBy(ctx, f.filter)
+		if err != nil || total != uint64(len(f.want)) {
+			t.Errorf("GetTotalTxsBy %+v = %d, %v, want %d", f.filter, total, err, len(f.want))
+		}
+	}
+
+	txs, err = repo.GetTxsBy(ctx, repository.TxFilter{Limit: 2})
+	if err != nil || len(txs) != 2 {
+		t.Fatalf("GetTxsBy with limit = %d txs, %v, want 2", len(txs), err)
+	}
+	txs, err = repo.GetTxsBy(ctx, repository.TxFilter{Limit: 2, Offset: 2})
+	if err != nil || len(txs) != 1 {
+		t.Fatalf("GetTxsBy with offset = %d txs, %v, want 1", len(txs), err)
+	}
+
+	heights, err := repo.GetHeightsWithoutWrapperIDs(ctx)
+	if err != nil || !jsonEqual(heights, []int64{2}) {
+		t.Fatalf("GetHeightsWithoutWrapperIDs = %v, %v, want [2]", heights, err)
+	}
+	if err = repo.UpdateWrapperID(ctx, 2, txHash(2, 1), wrapper.Hash); err != nil {
+		t.Fatalf("UpdateWrapperID: %v", err)
+	}
+	if heights, err = repo.GetHeightsWithoutWrapperIDs(ctx); err != nil || len(heights) != 0 {
+		t.Fatalf("GetHeightsWithoutWrapperIDs after update = %v, %v, want none", heights, err)
+	}
+
+	if err = repo.UpdateTxData(ctx, 2, txHash(2, 1), []byte(`{"source":"c"}`)); err != nil {
+		t.Fatalf("UpdateTxData: %v", err)
+	}
+	updated := newTx(2, 1, "Decrypted", nil, `{"source":"c"}`)
+	addTransferAccountTxs(t, repo, updated)
+	txs, err = repo.GetTxsBySourceOrTarget(ctx, "c")
+	if err != nil {
+		t.Fatalf("GetTxsBySourceOrTarget: %v", err)
+	}
+	checkStrings(t, "txs with updated data", hashes(txs), []string{"tx-2-1"})
+}
+
+func testVoteProposals(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addBlocks(t, repo, 1, 2)
+	addTxs(t, repo,
+		newTx(1, 0, "Decrypted", voteCode, `{"id":5,"vote":"yay","voter":"v1"}`),
+		newTx(1, 1, "Decrypted", voteCode, `{"id":6,"vote":"nay","voter":"v1"}`),
+		newTx(2, 0, "Decrypted", voteCode, `{"id":5,"vote":"nay","voter":"v2"}`),
+		newTx(2, 1, "Decrypted", updateCode, `{"id":5,"address":"v3"}`),
+	)
+
+	datas, err := repo.GetVoteProposalDatas(ctx, [][]byte{voteCode}, 5)
+	if err != nil {
+		t.Fatalf("GetVoteProposalDatas: %v", err)
+	}
+
+	// Latest votes go first
+	var voters []string
+	for _, data := range datas {
+		var vote struct {
+			Voter string `json:"voter"`
+		}
+		if err = json.Unmarshal(data, &vote); err != nil {
+			t.Fatalf("Unmarshal vote: %v", err)
+		}
+		voters = append(voters, vote.Voter)
+	}
+	checkStrings(t, "voters", voters, []string{"v2", "v1"})
+
+	if datas, err = repo.GetVoteProposalDatas(ctx, [][]byte{voteCode}, 7); err != nil || len(datas) != 0 {
+		t.Fatalf("GetVoteProposalDatas of unknown proposal = %d, %v", len(datas), err)
+	}
+}
+
+func testAccountUpdates(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addBlocks(t, repo, 1)
+	addTxs(t, repo,
+		newTx(1, 0, "Decrypted", updateCode, `{"address":"acc","threshold":2,"vp_code_hash":"vp","public_keys":["pk1","pk2"]}`),
+		newTx(1, 1, "Decrypted", updateCode, `{"address":"acc","threshold":null,"vp_code_hash":null,"public_keys":[]}`),
+		newTx(1, 2, "Decrypted", updateCode, `{"address":"other","threshold":1,"vp_code_hash":"vp2","public_keys":["pk3"]}`),
+		newTx(1, 3, "Decrypted", voteCode, `{"address":"acc","threshold":3}`),
+	)
+
+	codes := [][]byte{updateCode}
+
+	thresholds, err := repo.GetAccountThresholds(ctx, codes, "acc")
+	if err != nil {
+		t.Fatalf("GetAccountThresholds: %v", err)
+	}
+	var gotThresholds []string
+	for _, threshold := range thresholds {
+		if threshold == nil {
+			gotThresholds = append(gotThresholds, "null")
+		} else {
+			gotThresholds = append(gotThresholds, strconv.Itoa(int(*threshold)))
+		}
+	}
+	sort.Strings(gotThresholds)
+	checkStrings(t, "thresholds", gotThresholds, []string{"2", "null"})
+
+	vpCodes, err := repo.GetAccountVPCodes(ctx, codes, "acc")
+	if err != nil {
+		t.Fatalf("GetAccountVPCodes: %v", err)
+	}
+	var gotVPCodes []string
+	for _, vpCode := range vpCodes {
+		if vpCode == nil {
+			gotVPCodes = append(gotVPCodes, "null")
+		} else {
+			gotVPCodes = append(gotVPCodes, *vpCode)
+		}
+	}
+	sort.Strings(gotVPCodes)
+	checkStrings(t, "vp codes", gotVPCodes, []string{"null", "vp"})
+
+	publicKeys, err := repo.GetAccountPublicKeys(ctx, codes, "acc")
+	if err != nil {
+		t.Fatalf("GetAccountPublicKeys: %v", err)
+	}
+	sort.Slice(publicKeys, func(i, j int) bool { return len(publicKeys[i]) < len(publicKeys[j]) })
+	if !jsonEqual(publicKeys, [][]string{{}, {"pk1", "pk2"}}) {
+		t.Errorf("public keys = %q, want [[] [pk1 pk2]]", publicKeys)
+	}
+}
+
+func testSourceOrTarget(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	txs := []repository.Transaction{
+		newTx(1, 0, "Decrypted", nil, `{"source":"`+maspAddress+`","target":"a","token":"nam","amount":"1"}`),
+		newTx(1, 1, "Decrypted", nil, `{"source":"a","target":"`+maspAddress+`","token":"nam","amount":"2"}`),
+		newTx(1, 2, "Decrypted", nil, `{"source":"a","target":"b","token":"nam","amount":"3"}`),
+		newTx(1, 3, "Wrapper", nil, `{"source":"`+maspAddress+`"}`),
+		newTx(1, 4, "Decrypted", nil, ""),
+	}
+
+	addBlocks(t, repo, 1, 2)
+	addTxs(t, repo, txs...)
+	addTransferAccountTxs(t, repo, txs...)
+	// Txs are found by account txs, so a tx without them isn't returned
+	addTxs(t, repo, newTx(2, 0, "Decrypted", nil, `{"source":"a","target":"`+maspAddress+`","token":"nam","amount":"4"}`))
+
+	got, err := repo.GetTxsBySourceOrTarget(ctx, maspAddress)
+	if err != nil {
+		t.Fatalf("GetTxsBySourceOrTarget: %v", err)
+	}
+	checkStrings(t, "masp txs", hashes(got), []string{"tx-1-0", "tx-1-1"})
+}
+
+func testAccountTxs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	var accountTxs []repository.AccountTransaction
+	for height := int64(1); height <= 3; height++ {
+		for pos := int64(0); pos < 2; pos++ {
+			accountTxs = append(accountTxs, repository.AccountTransaction{
+				Address: "acc", TxHash: txHash(height, pos), BlockHeight: height, TxPos: pos, Role: repository.RoleSource,
+			})
+		}
+	}
+	accountTxs = append(accountTxs,
+		repository.AccountTransaction{Address: "acc", TxHash: txHash(2, 0), BlockHeight: 2, TxPos: 0, Role: repository.RoleTarget},
+		repository.AccountTransaction{Address: "other", TxHash: txHash(1, 0), BlockHeight: 1, TxPos: 0, Role: repository.RoleTarget},
+	)
+	if err := repo.AddAccountTransactions(ctx, accountTxs...); err != nil {
+		t.Fatalf("AddAccountTransactions: %v", err)
+	}
+
+	total, err := repo.GetTotalAccountTxs(ctx, []byte("acc"), "")
+	if err != nil || total != 6 {
+		t.Fatalf("GetTotalAccountTxs = %d, %v, want 6", total, err)
+	}
+	if total, err = repo.GetTotalAccountTxs(ctx, []byte("acc"), repository.RoleTarget); err != nil || total != 1 {
+		t.Fatalf("GetTotalAccountTxs of target = %d, %v, want 1", total, err)
+	}
+
+	targetTxs, err := repo.GetAccountTxs(ctx, []byte("acc"), repository.RoleTarget, 0, 0)
+	if err != nil || len(targetTxs) != 1 || string(targetTxs[0]) != "tx-2-0" {
+		t.Fatalf("GetAccountTxs of target = %q, %v, want [tx-2-0]", targetTxs, err)
+	}
+
+	page, err := repo.GetAccountTxs(ctx, []byte("acc"), "", 4, 1)
+	if err != nil {
+		t.Fatalf("GetAccountTxs: %v", err)
+	}
+	var got []string
+	for _, hash := range page {
+		got = append(got, string(hash))
+	}
+	checkStrings(t, "account txs page", got, []string{"tx-3-0", "tx-2-1", "tx-2-0", "tx-1-1"})
+
+	if err = repo.DeleteAccountTransactions(ctx, txHash(1, 0)); err != nil {
+		t.Fatalf("DeleteAccountTransactions: %v", err)
+	}
+	if total, err = repo.GetTotalAccountTxs(ctx, []byte("acc"), ""); err != nil || total != 5 {
+		t.Fatalf("GetTotalAccountTxs after delete = %d, %v, want 5", total, err)
+	}
+	if total, err = repo.GetTotalAccountTxs(ctx, []byte("other"), ""); err != nil || total != 0 {
+		t.Fatalf("GetTotalAccountTxs of other after delete = %d, %v, want 0", total, err)
+	}
+}
+
+func testCommitSignatures(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	var signatures []repository.CommitSignature
+	for height := int64(1); height <= 5; height++ {
+		signatures = append(signatures,
+			repository.CommitSignature{BlockID: blockID(height), BlockHeight: height, BlockIDFlag: 2, ValidatorAddress: []byte("v1"), Timestamp: height, Signature: []byte("sig")},
+		)
+		if height%2 == 0 {
+			signatures = append(signatures,
+				repository.CommitSignature{BlockID: blockID(height), BlockHeight: height, BlockIDFlag: 2, ValidatorAddress: []byte("v2"), Timestamp: height, Signature: []byte("sig")},
+			)
+		}
+	}
+	if err := repo.AddCommitSignatures(ctx, signatures...); err != nil {
+		t.Fatalf("AddCommitSignatures: %v", err)
+	}
+
+	for _, c := range []struct {
+		validator  string
+		start, end int64
+		want       int64
+	}{
+		{"v1", 1, 5, 5},
+		{"v1", 2, 3, 2},
+		{"v2", 1, 5, 2},
+		{"v3", 1, 5, 0},
+	} {
+		cnt, err := repo.GetCommitsCount(ctx, []byte(c.validator), c.start, c.end)
+		if err != nil || cnt != c.want {
+			t.Errorf("GetCommitsCount %s [%d, %d] = %d, %v, want %d", c.validator, c.start, c.end, cnt, err, c.want)
+		}
+	}
+}
+
+func testEvents(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	events := []repository.BlockEvent{
+		{BlockID: blockID(1), BlockHeight: 1, Kind: repository.EventKindTx, EventPos: 0, Type: "transfer", TxHash: txHash(1, 0),
+			Attributes: []repository.EventAttribute{{Key: "token", Value: "nam"}, {Key: "amount", Value: "1"}}},
+		{BlockID: blockID(1), BlockHeight: 1, Kind: repository.EventKindEndBlock, EventPos: 1, Type: "update",
+			Attributes: []repository.EventAttribute{{Key: "token", Value: "btc"}}},
+		{BlockID: blockID(2), BlockHeight: 2, Kind: repository.EventKindTx, EventPos: 0, Type: "transfer", TxHash: txHash(2, 0),
+			Attributes: []repository.EventAttribute{{Key: "token", Value: "btc"}}},
+	}
+	if err := repo.AddBlockEvents(ctx, events...); err != nil {
+		t.Fatalf("AddBlockEvents: %v", err)
+	}
+
+	all, err := repo.GetBlockEvents(ctx, repository.EventFilter{})
+	if err != nil {
+		t.Fatalf("GetBlockEvents: %v", err)
+	}
+	if !jsonEqual(all, []repository.BlockEvent{events[2], events[0], events[1]}) {
+		t.Errorf("GetBlockEvents = %+v", all)
+	}
+
+	for _, c := range []struct {
+		filter repository.EventFilter
+		want   []int64
+	}{
+		{repository.EventFilter{Type: "transfer"}, []int64{2, 1}},
+		{repository.EventFilter{TxHash: txHash(1, 0)}, []int64{1}},
+		{repository.EventFilter{FromHeight: 2}, []int64{2}},
+		{repository.EventFilter{ToHeight: 1}, []int64{1, 1}},
+		{repository.EventFilter{Key: "amount"}, []int64{1}},
+		{repository.EventFilter{Key: "token", Value: "btc"}, []int64{2, 1}},
+		{repository.EventFilter{Key: "token", Value: "eth"}, nil},
+		{repository.EventFilter{Limit: 1, Offset: 1}, []int64{1}},
+	} {
+		got, err := repo.GetBlockEvents(ctx, c.filter)
+		if err != nil {
+			t.Fatalf("GetBlockEvents %+v: %v", c.filter, err)
+		}
+		var heights []int64
+		for _, event := range got {
+			heights = append(heights, event.BlockHeight)
+		}
+		if !jsonEqual(heights, c.want) {
+			t.Errorf("GetBlockEvents %+v heights = %v, want %v", c.filter, heights, c.want)
+		}
+	}
+}
+
+func testFailedTxs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	createdAt := genesis.Add(time.Hour)
+	failed := []repository.FailedTx{
+		{BlockID: blockID(1), BlockHeight: 1, TxPos: 0, TxHash: txHash(1, 0), TxType: "Decrypted", Data: []byte("raw"), Error: "decode", CreatedAt: createdAt},
+		{BlockID: blockID(3), BlockHeight: 3, TxPos: 2, TxHash: txHash(3, 2), TxType: "Wrapper", Data: []byte("raw"), Error: "decode", CreatedAt: createdAt},
+		{BlockID: blockID(3), BlockHeight: 3, TxPos: 1, Data: []byte("raw"), Error: "decode", CreatedAt: createdAt},
+	}
+	if err := repo.AddFailedTxs(ctx, failed...); err != nil {
+		t.Fatalf("AddFailedTxs: %v", err)
+	}
+
+	got, err := repo.GetFailedTxs(ctx, 2, 0)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("GetFailedTxs = %d txs, %v, want 2", len(got), err)
+	}
+	if !got[0].CreatedAt.Equal(createdAt) {
+		t.Errorf("failed tx created at = %v, want %v", got[0].CreatedAt, createdAt)
+	}
+	got[0].CreatedAt, got[1].CreatedAt = createdAt, createdAt
+	if !jsonEqual(got, []repository.FailedTx{failed[1], failed[2]}) {
+		t.Errorf("GetFailedTxs = %+v", got)
+	}
+
+	heights, err := repo.GetFailedTxHeights(ctx)
+	if err != nil || !jsonEqual(heights, []int64{1, 3}) {
+		t.Fatalf("GetFailedTxHeights = %v, %v, want [1 3]", heights, err)
+	}
+
+	got, err = repo.GetBlockFailedTxs(ctx, 3)
+	if err != nil || len(got) != 2 {
+		t.Fatalf("GetBlockFailedTxs = %d txs, %v, want 2", len(got), err)
+	}
+	got[0].CreatedAt, got[1].CreatedAt = createdAt, createdAt
+	if !jsonEqual(got, []repository.FailedTx{failed[2], failed[1]}) {
+		t.Errorf("GetBlockFailedTxs = %+v", got)
+	}
+
+	if err = repo.DeleteFailedTx(ctx, 3, 1); err != nil {
+		t.Fatalf("DeleteFailedTx: %v", err)
+	}
+	got, err = repo.GetBlockFailedTxs(ctx, 3)
+	if err != nil || len(got) != 1 || got[0].TxPos != 2 {
+		t.Fatalf("GetBlockFailedTxs after delete = %+v, %v, want tx at 2", got, err)
+	}
+	heights, err = repo.GetFailedTxHeights(ctx)
+	if err != nil || !jsonEqual(heights, []int64{1, 3}) {
+		t.Fatalf("GetFailedTxHeights after delete = %v, %v, want [1 3]", heights, err)
+	}
+}
+
+func testRawTxs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	raw := []repository.RawTx{
+		{TxHash: txHash(2, 1), BlockID: blockID(2), BlockHeight: 2, TxPos: 1, Data: []byte("b")},
+		{TxHash: txHash(1, 0), BlockID: blockID(1), BlockHeight: 1, TxPos: 0, Data: []byte("a")},
+		{TxHash: txHash(2, 0), BlockID: blockID(2), BlockHeight: 2, TxPos: 0, Data: []byte("c")},
+		{TxHash: txHash(3, 0), BlockID: blockID(3), BlockHeight: 3, TxPos: 0, Data: []byte("d")},
+	}
+	if err := repo.AddRawTxs(ctx, raw...); err != nil {
+		t.Fatalf("AddRawTxs: %v", err)
+	}
+
+	got, err := repo.GetRawTxs(ctx, 1, 2)
+	if err != nil {
+		t.Fatalf("GetRawTxs: %v", err)
+	}
+	if !jsonEqual(got, []repository.RawTx{raw[1], raw[2], raw[0]}) {
+		t.Errorf("GetRawTxs = %+v", got)
+	}
+}
+
+func testIbcTransfers(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	transfer := func(height, pos int64, srcChannel, destChannel, denom string) repository.IbcTransfer {
+		return repository.IbcTransfer{
+			TxHash: txHash(height, pos), BlockHeight: height, TxPos: pos, MsgType: "transfer",
+			SourcePort: "transfer", SourceChannel: srcChannel, DestinationChannel: destChannel,
+			Denom: denom, Amount: "100", Sender: "a", Receiver: "b",
+		}
+	}
+	sent := transfer(1, 0, "channel-0", "", "nam")
+	received := transfer(2, 0, "channel-5", "channel-0", "uatom")
+	received.DestinationPort = "transfer"
+	received.Sequence = 7
+	received.Memo = "memo"
+	if err := repo.AddIbcTransfers(ctx, sent, received, transfer(2, 1, "channel-1", "", "nam")); err != nil {
+		t.Fatalf("AddIbcTransfers: %v", err)
+	}
+
+	filters := []struct {
+		filter repository.IbcTransferFilter
+		want   []string
+	}{
+		{repository.IbcTransferFilter{}, []string{"tx-2-1", "tx-2-0", "tx-1-0"}},
+		{repository.IbcTransferFilter{Channel: "channel-0"}, []string{"tx-2-0", "tx-1-0"}},
+		{repository.IbcTransferFilter{Denom: "nam"}, []string{"tx-2-1", "tx-1-0"}},
+		{repository.IbcTransferFilter{Channel: "channel-0", Denom: "nam"}, []string{"tx-1-0"}},
+		{repository.IbcTransferFilter{Limit: 1, Offset: 1}, []string{"tx-2-0"}},
+	}
+	for _, f := range filters {
+		transfers, err := repo.GetIbcTransfers(ctx, f.filter)
+		if err != nil {
+			t.Fatalf("GetIbcTransfers %+v: %v", f.filter, err)
+		}
+		var got []string
+		for _, transfer := range transfers {
+			got = append(got, string(transfer.TxHash))
+		}
+		checkStrings(t, "GetIbcTransfers", got, f.want)
+	}
+
+	transfers, err := repo.GetIbcTransfers(ctx, repository.IbcTransferFilter{Denom: "uatom"})
+	if err != nil || len(transfers) != 1 || !jsonEqual(transfers[0], received) {
+		t.Fatalf("GetIbcTransfers of received = %+v, %v", transfers, err)
+	}
+
+	if err = repo.DeleteIbcTransfers(ctx, txHash(2, 0)); err != nil {
+		t.Fatalf("DeleteIbcTransfers: %v", err)
+	}
+	if transfers, err = repo.GetIbcTransfers(ctx, repository.IbcTransferFilter{}); err != nil || len(transfers) != 2 {
+		t.Fatalf("GetIbcTransfers after delete = %d, %v, want 2", len(transfers), err)
+	}
+}
+
+func testMaspTxs(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	shielding := repository.MaspTx{
+		TxHash: txHash(1, 0), BlockHeight: 1, TxPos: 0, MaspPos: 1, ExpiryHeight: 10, OutputsCount: 1,
+		ValueBalance:      []repository.MaspAssetValue{{AssetType: "aa", Value: "-100"}},
+		TransparentInputs: []repository.MaspTransparentValue{{AssetType: "aa", Value: 100, Address: "bb"}},
+	}
+	unshielding := repository.MaspTx{
+		TxHash: txHash(1, 0), BlockHeight: 1, TxPos: 0, MaspPos: 0, SpendsCount: 2, ConvertsCount: 1, OutputsCount: 1,
+		ValueBalance:       []repository.MaspAssetValue{{AssetType: "aa", Value: "50"}, {AssetType: "cc", Value: "1"}},
+		TransparentOutputs: []repository.MaspTransparentValue{{AssetType: "aa", Value: 50, Address: "dd"}},
+	}
+	other := repository.MaspTx{TxHash: txHash(2, 0), BlockHeight: 2, TxPos: 0}
+	if err := repo.AddMaspTxs(ctx, shielding, unshielding, other); err != nil {
+		t.Fatalf("AddMaspTxs: %v", err)
+	}
+
+	txs, err := repo.GetMaspTxs(ctx, txHash(1, 0))
+	if err != nil || len(txs) != 2 || !jsonEqual(txs[0], unshielding) || !jsonEqual(txs[1], shielding) {
+		t.Fatalf("GetMaspTxs = %+v, %v", txs, err)
+	}
+
+	if err = repo.DeleteMaspTxs(ctx, txHash(1, 0)); err != nil {
+		t.Fatalf("DeleteMaspTxs: %v", err)
+	}
+	if txs, err = repo.GetMaspTxs(ctx, txHash(1, 0)); err != nil || len(txs) != 0 {
+		t.Fatalf("GetMaspTxs after delete = %d, %v, want 0", len(txs), err)
+	}
+	if txs, err = repo.GetMaspTxs(ctx, txHash(2, 0)); err != nil || len(txs) != 1 {
+		t.Fatalf("GetMaspTxs of other tx = %d, %v, want 1", len(txs), err)
+	}
+}
+
+func testMaspAssets(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	nam := repository.MaspAsset{AssetType: []byte("asset-nam"), Token: "nam", Denom: 6, Position: 1, Epoch: ptr(int64(3))}
+	btc := repository.MaspAsset{AssetType: []byte("asset-btc"), Token: "btc", Denom: 8}
+	if err := repo.AddMaspAssets(ctx, nam, btc); err != nil {
+		t.Fatalf("AddMaspAssets: %v", err)
+	}
+	// Saved asset types are skipped
+	if err := repo.AddMaspAssets(ctx, repository.MaspAsset{AssetType: nam.AssetType, Token: "other"}); err != nil {
+		t.Fatalf("AddMaspAssets of saved asset type: %v", err)
+	}
+
+	assets, err := repo.GetMaspAssets(ctx)
+	if err != nil || len(assets) != 2 || !jsonEqual(assets[0], btc) || !jsonEqual(assets[1], nam) {
+		t.Fatalf("GetMaspAssets = %+v, %v", assets, err)
+	}
+	assets, err = repo.GetMaspAssets(ctx, nam.AssetType, []byte("unknown"))
+	if err != nil || len(assets) != 1 || !jsonEqual(assets[0], nam) {
+		t.Fatalf("GetMaspAssets of nam = %+v, %v", assets, err)
+	}
+}
+
+// addFeeTxs adds wrappers with fees in blocks 1 and 3 and the decrypted tx of the first wrapper in block 2.
+func addFeeTxs(t *testing.T, repo repository.Repository) {
+	t.Helper()
+
+	// Blocks 1 and 2 are on the first day, block 3 is on the next one
+	addBlocks(t, repo, 1, 2, 3)
+
+	wrapper := func(height, pos int64, token string, gasLimit, gasUsed uint64, feePaid string) repository.Transaction {
+		tx := newTx(height, pos, "Wrapper", nil, "")
+		tx.FeeToken = token
+		tx.FeePayer = "payer-" + token
+		tx.GasLimitMultiplier = ptr(gasLimit)
+		tx.GasUsed = ptr(gasUsed)
+		tx.FeePaid = ptr(feePaid)
+		return tx
+	}
+	decrypted := newTx(2, 0, "Decrypted", nil, "")
+	decrypted.WrapperID = txHash(1, 0)
+	decrypted.GasUsed = ptr(uint64(7))
+
+	addTxs(t, repo,
+		wrapper(1, 0, "nam", 100, 10, "1.5"),
+		wrapper(1, 1, "btc", 50, 5, "2"),
+		decrypted,
+		wrapper(3, 0, "nam", 200, 20, "3"),
+	)
+}
+
+// checkFeesByToken checks fee stats by token of txs added by addFeeTxs.
+func checkFeesByToken(t *testing.T, repo repository.Repository) {
+	t.Helper()
+
+	stats, err := repo.GetFeeStats(context.Background(), repository.FeeStatsFilter{GroupBy: repository.FeeGroupByToken})
+	if err != nil || len(stats) != 2 {
+		t.Fatalf("GetFeeStats by token = %d, %v, want 2 rows", len(stats), err)
+	}
+	if stats[0].FeeToken != "btc" || stats[0].TxsCount != 1 || stats[0].GasLimit != 50 || stats[0].GasUsed != 5 {
+		t.Errorf("btc fee stats = %+v", stats[0])
+	}
+	checkFloat(t, "btc fee paid", stats[0].FeePaid, 2)
+	// Gas used of the executed wrapper is taken from its decrypted tx
+	if stats[1].FeeToken != "nam" || stats[1].TxsCount != 2 || stats[1].GasLimit != 300 || stats[1].GasUsed != 27 {
+		t.Errorf("nam fee stats = %+v", stats[1])
+	}
+	checkFloat(t, "nam fee paid", stats[1].FeePaid, 4.5)
+}
+
+func testFeeStats(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addFeeTxs(t, repo)
+	checkFeesByToken(t, repo)
+
+	stats, err := repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByBlock, FeeToken: "nam"})
+	if err != nil || len(stats) != 2 || stats[0].Height != 3 || stats[1].Height != 1 {
+		t.Fatalf("GetFeeStats by block = %+v, %v", stats, err)
+	}
+
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByDay})
+	if err != nil || len(stats) != 3 {
+		t.Fatalf("GetFeeStats by day = %+v, %v", stats, err)
+	}
+	day := time.Date(genesis.Year(), genesis.Month(), genesis.Day(), 0, 0, 0, 0, time.UTC)
+	if !stats[0].Day.Equal(day.AddDate(0, 0, 1)) || stats[0].FeeToken != "nam" || !stats[1].Day.Equal(day) || stats[1].FeeToken != "btc" {
+		t.Errorf("GetFeeStats by day = %+v", stats)
+	}
+
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByBlock, FromHeight: 2, ToHeight: 3})
+	if err != nil || len(stats) != 1 || stats[0].Height != 3 {
+		t.Fatalf("GetFeeStats by height range = %+v, %v", stats, err)
+	}
+
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByToken, FeePayer: "payer-nam"})
+	if err != nil || len(stats) != 1 || stats[0].FeeToken != "nam" || stats[0].TxsCount != 2 {
+		t.Fatalf("GetFeeStats by payer = %+v, %v", stats, err)
+	}
+
+	if _, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: "week"}); err == nil {
+		t.Fatalf("GetFeeStats with unknown grouping succeeded")
+	}
+}
+
+func testIndexerStatus(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	if err := repo.Ping(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if _, err := repo.GetReplicasStatus(ctx); err != nil {
+		t.Fatalf("GetReplicasStatus: %v", err)
+	}
+	if _, err := repo.GetIndexerStatus(ctx); !errors.Is(err, repository.ErrNotFound) {
+		t.Fatalf("GetIndexerStatus of empty repository error = %v, want ErrNotFound", err)
+	}
+
+	status := repository.IndexerStatus{
+		IndexedHeight: 10,
+		ChainHeight:   12,
+		SyncState:     repository.SyncStateSyncing,
+		Version:       "v1",
+		Checksums:     "abc",
+		UpdatedAt:     genesis,
+	}
+	for i := 0; i < 2; i++ {
+		if err := repo.SaveIndexerStatus(ctx, status); err != nil {
+			t.Fatalf("SaveIndexerStatus: %v", err)
+		}
+
+		got, err := repo.GetIndexerStatus(ctx)
+		if err != nil {
+			t.Fatalf("GetIndexerStatus: %v", err)
+		}
+		if !got.UpdatedAt.Equal(status.UpdatedAt) || (got.LastBlockTime == nil) != (status.LastBlockTime == nil) ||
+			(got.LastBlockTime != nil && !got.LastBlockTime.Equal(*status.LastBlockTime)) {
+			t.Errorf("status times = %v, %v, want %v, %v", got.UpdatedAt, got.LastBlockTime, status.UpdatedAt, status.LastBlockTime)
+		}
+		got.UpdatedAt, got.LastBlockTime = status.UpdatedAt, status.LastBlockTime
+		if got != status {
+			t.Errorf("GetIndexerStatus = %+v, want %+v", got, status)
+		}
+
+		status.IndexedHeight, status.SyncState, status.ErrorCount = 12, repository.SyncStateSynced, 1
+		status.LastBlockTime, status.UpdatedAt = ptr(genesis), genesis.Add(time.Minute)
+	}
+}
+
+func testPrune(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	transfer := func(height, pos int64, source, target, amount string) repository.Transaction {
+		return newTx(height, pos, "Decrypted", nil, `{"source":"`+source+`","target":"`+target+`","token":"nam","amount":"`+amount+`"}`)
+	}
+
+	addBlocks(t, repo, 1, 2, 3)
+	addTxs(t, repo,
+		transfer(1, 0, "a", maspAddress, "10"),
+		transfer(1, 1, maspAddress, "b", "2.5"),
+		transfer(2, 0, "a", maspAddress, "invalid"),
+		transfer(2, 1, "a", "b", "100"),
+		transfer(3, 0, "a", maspAddress, "1"),
+	)
+	if err := repo.AddAccountTransactions(ctx,
+		repository.AccountTransaction{Address: "a", TxHash: txHash(1, 0), BlockHeight: 1, Role: repository.RoleSource},
+		repository.AccountTransaction{Address: "a", TxHash: txHash(1, 0), BlockHeight: 1, Role: repository.RoleFeePayer},
+		repository.AccountTransaction{Address: "a", TxHash: txHash(2, 1), BlockHeight: 2, TxPos: 1},
+		repository.AccountTransaction{Address: "a", TxHash: txHash(3, 0), BlockHeight: 3},
+	); err != nil {
+		t.Fatalf("AddAccountTransactions: %v", err)
+	}
+
+	for _, r := range [][2]int64{{1, 1}, {2, 2}} {
+		if err := repo.PruneBlocks(ctx, r[0], r[1], maspAddress); err != nil {
+			t.Fatalf("PruneBlocks %v: %v", r, err)
+		}
+	}
+
+	if height, err := repo.GetEarliestHeight(ctx); err != nil || height != 3 {
+		t.Fatalf("GetEarliestHeight after prune = %d, %v, want 3", height, err)
+	}
+
+	total, err := repo.GetTotalAccountTxs(ctx, []byte("a"), "")
+	if err != nil || total != 3 {
+		t.Fatalf("GetTotalAccountTxs after prune = %d, %v, want 3", total, err)
+	}
+	txHashes, err := repo.GetAccountTxs(ctx, []byte("a"), "", 0, 0)
+	if err != nil || len(txHashes) != 1 {
+		t.Fatalf("GetAccountTxs after prune = %d, %v, want 1", len(txHashes), err)
+	}
+
+	shielded, err := repo.GetPrunedShielded(ctx)
+	if err != nil || len(shielded) != 1 || shielded[0].Token != "nam" {
+		t.Fatalf("GetPrunedShielded = %+v, %v", shielded, err)
+	}
+	checkFloat(t, "pruned shielded amount", shielded[0].Amount, 7.5)
+}
+
+func testPruneFees(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addFeeTxs(t, repo)
+	for _, r := range [][2]int64{{1, 1}, {2, 2}} {
+		if err := repo.PruneBlocks(ctx, r[0], r[1], maspAddress); err != nil {
+			t.Fatalf("PruneBlocks %v: %v", r, err)
+		}
+	}
+
+	// Fees of pruned wrappers are kept in stats by token and day
+	checkFeesByToken(t, repo)
+
+	stats, err := repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByDay})
+	day := time.Date(genesis.Year(), genesis.Month(), genesis.Day(), 0, 0, 0, 0, time.UTC)
+	if err != nil || len(stats) != 3 || !stats[1].Day.Equal(day) || stats[1].FeeToken != "btc" ||
+		!stats[2].Day.Equal(day) || stats[2].FeeToken != "nam" || stats[2].GasUsed != 7 {
+		t.Fatalf("GetFeeStats by day after prune = %+v, %v", stats, err)
+	}
+	checkFloat(t, "pruned nam fee paid", stats[2].FeePaid, 1.5)
+
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByToken, FeePayer: "payer-nam"})
+	if err != nil || len(stats) != 1 || stats[0].FeeToken != "nam" || stats[0].TxsCount != 2 {
+		t.Fatalf("GetFeeStats by payer after prune = %+v, %v", stats, err)
+	}
+
+	// Pruned blocks are not in stats by block and in stats of height ranges
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByBlock})
+	if err != nil || len(stats) != 1 || stats[0].Height != 3 {
+		t.Fatalf("GetFeeStats by block after prune = %+v, %v", stats, err)
+	}
+	stats, err = repo.GetFeeStats(ctx, repository.FeeStatsFilter{GroupBy: repository.FeeGroupByToken, FromHeight: 1})
+	if err != nil || len(stats) != 1 || stats[0].TxsCount != 1 {
+		t.Fatalf("GetFeeStats by height range after prune = %+v, %v", stats, err)
+	}
+}
+
+func testPartitions(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	for _, height := range []int64{1, 1000, 100000} {
+		if err := repo.EnsurePartitions(ctx, height); err != nil {
+			t.Fatalf("EnsurePartitions %d: %v", height, err)
+		}
+		addBlocks(t, repo, height)
+		addTxs(t, repo, newTx(height, 0, "Wrapper", nil, ""))
+		if err := repo.AddCommitSignatures(ctx, repository.CommitSignature{BlockID: blockID(height), BlockHeight: height, BlockIDFlag: 2, ValidatorAddress: []byte("v1"), Timestamp: height, Signature: []byte("sig")}); err != nil {
+			t.Fatalf("AddCommitSignatures: %v", err)
+		}
+	}
+
+	err := repo.RunInTransaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+		if err := tx.EnsurePartitions(ctx, 200000); err != nil {
+			return err
+		}
+		addBlocks(t, tx, 200000)
+		addTxs(t, tx, newTx(200000, 0, "Wrapper", nil, ""))
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("EnsurePartitions inside of transaction: %v", err)
+	}
+
+	if total, err := repo.GetTotalTxsBy(ctx, repository.TxFilter{}); err != nil || total != 4 {
+		t.Fatalf("GetTotalTxsBy = %d, %v, want 4", total, err)
+	}
+	if cnt, err := repo.GetCommitsCount(ctx, []byte("v1"), 1000, 100000); err != nil || cnt != 2 {
+		t.Fatalf("GetCommitsCount = %d, %v, want 2", cnt, err)
+	}
+}
+
+func testTransactionCommit(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	err := repo.RunInTransaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+		if err := tx.AddBlock(ctx, newBlock(1)); err != nil {
+			return err
+		}
+		if err := tx.AddTransactions(ctx, newTx(1, 0, "Wrapper", nil, "")); err != nil {
+			return err
+		}
+		// Rows are visible inside of transaction
+		height, err := tx.GetLastHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if height != 1 {
+			t.Errorf("GetLastHeight inside of transaction = %d, want 1", height)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RunInTransaction: %v", err)
+	}
+
+	if height, err := repo.GetLastHeight(ctx); err != nil || height != 1 {
+		t.Fatalf("GetLastHeight after commit = %d, %v, want 1", height, err)
+	}
+	if total, err := repo.GetTotalTxsBy(ctx, repository.TxFilter{}); err != nil || total != 1 {
+		t.Fatalf("GetTotalTxsBy after commit = %d, %v, want 1", total, err)
+	}
+}
+
+func testTransactionRollback(t *testing.T, repo repository.Repository) {
+	ctx := context.Background()
+
+	addBlocks(t, repo, 1)
+
+	txErr := errors.New("stop")
+	err := repo.RunInTransaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+		if err := tx.AddBlock(ctx, newBlock(2)); err != nil {
+			return err
+		}
+		if err := tx.AddTransactions(ctx, newTx(2, 0, "Wrapper", nil, "")); err != nil {
+			return err
+		}
+		if err := tx.DeleteBlocks(ctx, 1, 1); err != nil {
+			return err
+		}
+		if err := tx.RunInTransaction(ctx, func(context.Context, repository.Repository) error { return nil }); err == nil {
+			t.Errorf("nested RunInTransaction succeeded")
+		}
+		return txErr
+	})
+	if err == nil {
+		t.Fatalf("RunInTransaction returned no error")
+	}
+
+	if height, err := repo.GetLastHeight(ctx); err != nil || height != 1 {
+		t.Fatalf("GetLastHeight after rollback = %d, %v, want 1", height, err)
+	}
+	if total, err := repo.GetTotalTxsBy(ctx, repository.TxFilter{}); err != nil || total != 0 {
+		t.Fatalf("GetTotalTxsBy after rollback = %d, %v, want 0", total, err)
+	}
+
+	// The repository is usable after rollback
+	addBlocks(t, repo, 2)
+}
@@ -0,0 +1,185 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (s *sqlite) AddAccountTransactions(ctx context.Context, txs ...repository.AccountTransaction) error {
+	rows := make([][]any, 0, len(txs))
+	for _, tx := range txs {
+		// Addresses are compared with blobs, text never equals blob in SQLite
+		rows = append(rows, []any{[]byte(tx.Address), tx.TxHash, tx.BlockHeight, tx.TxPos, tx.Role})
+	}
+
+	return s.insertRows(ctx, "AddAccountTransactions", accountTransactionsTable, []string{"address", "tx_hash", "block_height", "tx_pos", "role"}, rows)
+}
+
+// GetTotalAccountTxs counts txs of the address, a tx is counted once if the address has several roles in it.
+// Txs of pruned blocks are counted only if role is empty, pruned totals are not split by roles.
+func (s *sqlite) GetTotalAccountTxs(ctx context.Context, address []byte, role string) (uint64, error) {
+	builder := s.psql.Select().From(accountTransactionsTable).Where(sq.Eq{"address": address})
+
+	if role == "" {
+		builder = builder.Column(sq.Expr("COUNT(DISTINCT tx_hash) + COALESCE((SELECT txs_count FROM "+prunedAccountTotalsTable+" WHERE address = ?), 0)", address))
+	} else {
+		builder = builder.Column("COUNT(DISTINCT tx_hash)").Where(sq.Eq{"role": role})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetTotalAccountTxs")
+	}
+
+	var total uint64
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, errors.New(err, "Exec SQL for GetTotalAccountTxs")
+	}
+
+	return total, nil
+}
+
+// GetAccountTxs returns hashes of txs of the address with the role or with any role if it's empty, latest first.
+func (s *sqlite) GetAccountTxs(ctx context.Context, address []byte, role string, limit, offset uint64) ([][]byte, error) {
+	builder := s.psql.Select("tx_hash").
+		From(accountTransactionsTable).
+		Where(sq.Eq{"address": address}).
+		GroupBy("tx_hash", "block_height", "tx_pos").
+		OrderBy("block_height DESC", "tx_pos DESC")
+
+	if role != "" {
+		builder = builder.Where(sq.Eq{"role": role})
+	}
+
+	builder = page(builder, limit, offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetAccountTxs")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetAccountTxs")
+	}
+	defer rows.Close()
+
+	var txHashes [][]byte
+	for rows.Next() {
+		var txHash []byte
+		if err = rows.Scan(&txHash); err != nil {
+			return nil, errors.New(err, "Scan result for GetTxsBy")
+		}
+		txHashes = append(txHashes, txHash)
+	}
+
+	return txHashes, nil
+}
+
+func (s *sqlite) DeleteAccountTransactions(ctx context.Context, txHash []byte) error {
+	query, args, err := s.psql.Delete(accountTransactionsTable).Where(sq.Eq{"tx_hash": txHash}).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteAccountTransactions")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteAccountTransactions")
+}
+
+func (s *sqlite) GetAccountThresholds(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*uint8, error) {
+	query, args, err := s.psql.Select("data ->> 'threshold'").
+		From(transactionsTable).
+		Where(sq.Eq{"code": updateAccountCodes}).
+		Where(sq.Eq{"data ->> 'address'": accountID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetAccountThresholds")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetAccountThresholds")
+	}
+	defer rows.Close()
+
+	var thresholds []*uint8
+
+	for rows.Next() {
+		var threshold *uint8
+		if err = rows.Scan(&threshold); err != nil {
+			return nil, errors.New(err, "Scan rows for GetAccountThresholds")
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, nil
+}
+
+func (s *sqlite) GetAccountVPCodes(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([]*string, error) {
+	query, args, err := s.psql.Select("data ->> 'vp_code_hash'").
+		From(transactionsTable).
+		Where(sq.Eq{"code": updateAccountCodes}).
+		Where(sq.Eq{"data ->> 'address'": accountID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetAccountVPCodes")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetAccountVPCodes")
+	}
+	defer rows.Close()
+
+	var vpCodes []*string
+
+	for rows.Next() {
+		var vpCode *string
+		if err = rows.Scan(&vpCode); err != nil {
+			return nil, errors.New(err, "Scan rows for GetAccountVPCodes")
+		}
+		vpCodes = append(vpCodes, vpCode)
+	}
+
+	return vpCodes, nil
+}
+
+func (s *sqlite) GetAccountPublicKeys(ctx context.Context, updateAccountCodes [][]byte, accountID string) ([][]string, error) {
+	query, args, err := s.psql.Select("(SELECT json_group_array(value) FROM json_each(data, '$.public_keys'))").
+		From(transactionsTable).
+		Where(sq.Eq{"code": updateAccountCodes}).
+		Where(sq.Eq{"data ->> 'address'": accountID}).
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetAccountPublicKeys")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetAccountPublicKeys")
+	}
+	defer rows.Close()
+
+	var publicKeys [][]string
+
+	for rows.Next() {
+		var (
+			keysJSON []byte
+			keys     []string
+		)
+		if err = rows.Scan(&keysJSON); err != nil {
+			return nil, errors.New(err, "Scan rows for GetAccountPublicKeys")
+		}
+		if err = json.Unmarshal(keysJSON, &keys); err != nil {
+			return nil, errors.New(err, "Unmarshal public keys")
+		}
+		publicKeys = append(publicKeys, keys)
+	}
+
+	return publicKeys, nil
+}
@@ -0,0 +1,159 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (s *sqlite) AddBlock(ctx context.Context, block repository.Block) error {
+	query, args, err := s.psql.Insert(blocksTable).
+		Columns("block_id", "header_version_app", "header_version_block", "header_chain_id", "header_height", "header_time",
+			"header_last_block_id_hash", "header_last_block_id_parts_header_total", "header_last_block_id_parts_header_hash", "header_last_commit_hash",
+			"header_data_hash", "header_validators_hash", "header_next_validators_hash", "header_consensus_hash", "header_app_hash",
+			"header_last_results_hash", "header_evidence_hash", "header_proposer_address",
+			"commit_height", "commit_round", "commit_block_id_hash", "commit_block_id_parts_header_total", "commit_block_id_parts_header_hash").
+		Values(block.BlockID, block.HeaderVersionApp, block.HeaderVersionBlock, block.HeaderChainID, block.HeaderHeight, block.HeaderTime.UTC(),
+			block.HeaderLastBlockIDHash, block.HeaderLastBlockIDPartsHeaderTotal, block.HeaderLastBlockIDPartsHeaderHash, block.HeaderLastCommitHash,
+			block.HeaderDataHash, block.HeaderValidatorsHash, block.HeaderNextValidatorsHash, block.HeaderConsensusHash, block.HeaderAppHash,
+			block.HeaderLastResultsHash, block.HeaderEvidenceHash, block.HeaderProposerAddress,
+			block.CommitHeight, block.CommitRound, block.CommitBlockIDHash, block.CommitBlockIDPartsHeaderTotal, block.CommitBlockIDPartsHeaderHash).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for AddBlock")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for AddBlock")
+}
+
+func (s *sqlite) GetLastHeight(ctx context.Context) (int64, error) {
+	query, args, err := s.psql.Select("COALESCE(MAX(header_height), 0)").From(blocksTable).ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetLastHeight")
+	}
+
+	var height int64
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&height)
+	if err == sql.ErrNoRows {
+		return 0, nil
+	}
+
+	return height, errors.New(err, "Exec SQL for GetLastHeight")
+}
+
+func (s *sqlite) GetBlockBy(ctx context.Context, filter repository.BlockFilter) (repository.Block, error) {
+	builder := s.psql.Select("block_id", "header_version_app", "header_version_block", "header_chain_id", "header_height", "header_time",
+		"header_last_block_id_hash", "header_last_block_id_parts_header_total", "header_last_block_id_parts_header_hash", "header_last_commit_hash",
+		"header_data_hash", "header_validators_hash", "header_next_validators_hash", "header_consensus_hash", "header_app_hash",
+		"header_last_results_hash", "header_evidence_hash", "header_proposer_address",
+		"commit_height", "commit_round", "commit_block_id_hash", "commit_block_id_parts_header_total", "commit_block_id_parts_header_hash").
+		From(blocksTable)
+
+	if filter.Height != 0 {
+		builder = builder.Where(sq.Eq{"header_height": filter.Height})
+	}
+	if len(filter.BlockID) != 0 {
+		builder = builder.Where(sq.Eq{"block_id": filter.BlockID})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return repository.Block{}, errors.New(err, "Build SQL for GetBlockBy")
+	}
+
+	var block repository.Block
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&block.BlockID, &block.HeaderVersionApp, &block.HeaderVersionBlock, &block.HeaderChainID, &block.HeaderHeight, &block.HeaderTime,
+		&block.HeaderLastBlockIDHash, &block.HeaderLastBlockIDPartsHeaderTotal, &block.HeaderLastBlockIDPartsHeaderHash,
+		&block.HeaderLastCommitHash, &block.HeaderDataHash, &block.HeaderValidatorsHash, &block.HeaderNextValidatorsHash, &block.HeaderConsensusHash, &block.HeaderAppHash,
+		&block.HeaderLastResultsHash, &block.HeaderEvidenceHash, &block.HeaderProposerAddress,
+		&block.CommitHeight, &block.CommitRound, &block.CommitBlockIDHash, &block.CommitBlockIDPartsHeaderTotal, &block.CommitBlockIDPartsHeaderHash)
+	if err == sql.ErrNoRows {
+		return block, repository.ErrNotFound
+	}
+
+	return block, errors.New(err, "Exec SQL for GetBlockBy")
+}
+
+func (s *sqlite) GetLatestBlocks(ctx context.Context, cnt, offset uint64) ([]*repository.Block, error) {
+	builder := s.psql.Select("block_id", "header_version_app", "header_version_block", "header_chain_id", "header_height", "header_time",
+		"header_last_block_id_hash", "header_last_block_id_parts_header_total", "header_last_block_id_parts_header_hash", "header_last_commit_hash",
+		"header_data_hash", "header_validators_hash", "header_next_validators_hash", "header_consensus_hash", "header_app_hash",
+		"header_last_results_hash", "header_evidence_hash", "header_proposer_address",
+		"commit_height", "commit_round", "commit_block_id_hash", "commit_block_id_parts_header_total", "commit_block_id_parts_header_hash").
+		From(blocksTable).OrderBy("header_height DESC")
+
+	builder = page(builder, cnt, offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetLatestBlocks")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetLatestBlocks")
+	}
+	defer rows.Close()
+
+	var blocks []*repository.Block
+	for rows.Next() {
+		var block repository.Block
+		if err = rows.Scan(&block.BlockID, &block.HeaderVersionApp, &block.HeaderVersionBlock, &block.HeaderChainID, &block.HeaderHeight, &block.HeaderTime,
+			&block.HeaderLastBlockIDHash, &block.HeaderLastBlockIDPartsHeaderTotal, &block.HeaderLastBlockIDPartsHeaderHash,
+			&block.HeaderLastCommitHash, &block.HeaderDataHash, &block.HeaderValidatorsHash, &block.HeaderNextValidatorsHash, &block.HeaderConsensusHash, &block.HeaderAppHash,
+			&block.HeaderLastResultsHash, &block.HeaderEvidenceHash, &block.HeaderProposerAddress,
+			&block.CommitHeight, &block.CommitRound, &block.CommitBlockIDHash, &block.CommitBlockIDPartsHeaderTotal, &block.CommitBlockIDPartsHeaderHash); err != nil {
+			return nil, errors.New(err, "Scan result for GetLatestBlocks")
+		}
+		blocks = append(blocks, &block)
+	}
+
+	return blocks, nil
+}
+
+// DeleteBlocks removes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+func (s *sqlite) DeleteBlocks(ctx context.Context, fromHeight, toHeight int64) error {
+	var (
+		query string
+		args  []any
+		err   error
+	)
+
+	for _, table := range []string{transactionsTable, commitSignaturesTable, accountTransactionsTable, rawTxsTable, failedTxsTable, blockEventsTable, ibcTransfersTable, maspTxsTable} {
+		query, args, err = s.psql.Delete(table).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			ToSql()
+		if err != nil {
+			return errors.New(err, "Build SQL for DeleteBlocks "+table)
+		}
+		if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+			return errors.New(err, "Exec SQL for DeleteBlocks "+table)
+		}
+	}
+
+	blockIDs := sq.Expr("block_id IN (SELECT block_id FROM "+blocksTable+" WHERE header_height >= ? AND header_height <= ?)", fromHeight, toHeight)
+
+	query, args, err = s.psql.Delete(evidencesTable).Where(blockIDs).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteBlocks "+evidencesTable)
+	}
+	if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for DeleteBlocks "+evidencesTable)
+	}
+
+	query, args, err = s.psql.Delete(blocksTable).
+		Where(sq.GtOrEq{"header_height": fromHeight}).
+		Where(sq.LtOrEq{"header_height": toHeight}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteBlocks")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteBlocks")
+}
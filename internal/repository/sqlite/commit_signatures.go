@@ -0,0 +1,39 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (s *sqlite) AddCommitSignatures(ctx context.Context, signatures ...repository.CommitSignature) error {
+	rows := make([][]any, 0, len(signatures))
+	for _, signature := range signatures {
+		rows = append(rows, []any{signature.BlockID, signature.BlockHeight, signature.BlockIDFlag, signature.ValidatorAddress, signature.Timestamp, signature.Signature})
+	}
+
+	return s.insertRows(ctx, "AddCommitSignatures", commitSignaturesTable, []string{"block_id", "block_height", "block_id_flag", "validator_address", "timestamp", "signature"}, rows)
+}
+
+func (s *sqlite) GetCommitsCount(ctx context.Context, validatorAddress []byte, start, end int64) (int64, error) {
+	query, args, err := s.psql.Select("COUNT(*)").
+		From(commitSignaturesTable).
+		Where(sq.Eq{"validator_address": validatorAddress}).
+		Where(sq.GtOrEq{"block_height": start}).
+		Where(sq.LtOrEq{"block_height": end}).
+		ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetCommitsCount")
+	}
+
+	var cnt int64
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&cnt)
+	if err == sql.ErrNoRows {
+		return 0, repository.ErrNotFound
+	}
+
+	return cnt, errors.New(err, "Exec SQL for GetCommitsCount")
+}
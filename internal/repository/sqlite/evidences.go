@@ -0,0 +1,16 @@
+package sqlite
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (s *sqlite) AddEvidences(ctx context.Context, evidences ...repository.Evidence) error {
+	rows := make([][]any, 0, len(evidences))
+	for _, evidence := range evidences {
+		rows = append(rows, []any{evidence.BlockID, evidence.Height, evidence.Time, evidence.Address, evidence.TotalVotingPower, evidence.ValidatorPower})
+	}
+
+	return s.insertRows(ctx, "AddEvidences", evidencesTable, []string{"block_id", "height", "time", "address", "total_voting_power", "validator_power"}, rows)
+}
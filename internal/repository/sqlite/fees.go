@@ -0,0 +1,131 @@
+package sqlite
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// GetFeeStats aggregates fees of wrapper txs. Gas used is taken from the decrypted tx of the wrapper
+// if it was executed and from the wrapper itself otherwise. Decrypted txs are always in the block
+// following their wrapper. Aggregates of pruned blocks are added to stats grouped by day or token
+// if no height range is given.
+func (s *sqlite) GetFeeStats(ctx context.Context, filter repository.FeeStatsFilter) ([]repository.FeeStats, error) {
+	var groupColumns []string
+	switch filter.GroupBy {
+	case repository.FeeGroupByBlock:
+		groupColumns = []string{"height"}
+	case repository.FeeGroupByDay:
+		groupColumns = []string{"day"}
+	case repository.FeeGroupByToken:
+	default:
+		return nil, errors.Create("unknown fee stats grouping: " + filter.GroupBy)
+	}
+	groupColumns = append(groupColumns, "fee_token")
+
+	// Nested builders use ? placeholders, they are replaced by the outer one
+	fees := sq.Select(
+		"w.block_height AS height",
+		"date(b.header_time) AS day",
+		"w.fee_token",
+		"1 AS txs_count",
+		"w.gas_limit_multiplier AS gas_limit",
+		"COALESCE(d.gas_used, w.gas_used) AS gas_used",
+		"w.fee_paid",
+	).
+		From(transactionsTable + " w").
+		Join(blocksTable + " b USING (block_id)").
+		LeftJoin(transactionsTable + " d ON d.wrapper_id = w.hash AND d.block_height = w.block_height + 1").
+		Where("w.fee_paid IS NOT NULL")
+
+	if filter.FeeToken != "" {
+		fees = fees.Where(sq.Eq{"w.fee_token": filter.FeeToken})
+	}
+	if filter.FeePayer != "" {
+		fees = fees.Where(sq.Eq{"w.fee_payer": filter.FeePayer})
+	}
+	if filter.FromHeight > 0 {
+		fees = fees.Where(sq.GtOrEq{"w.block_height": filter.FromHeight})
+	}
+	if filter.ToHeight > 0 {
+		fees = fees.Where(sq.LtOrEq{"w.block_height": filter.ToHeight})
+	}
+
+	if filter.GroupBy != repository.FeeGroupByBlock && filter.FromHeight == 0 && filter.ToHeight == 0 {
+		pruned := sq.Select("NULL", "day", "fee_token", "txs_count", "gas_limit", "gas_used", "fee_paid").From(prunedFeesTable)
+		if filter.FeeToken != "" {
+			pruned = pruned.Where(sq.Eq{"fee_token": filter.FeeToken})
+		}
+		if filter.FeePayer != "" {
+			pruned = pruned.Where(sq.Eq{"fee_payer": filter.FeePayer})
+		}
+		fees = fees.SuffixExpr(sq.ConcatExpr("UNION ALL ", pruned))
+	}
+
+	columns := append([]string{}, groupColumns...)
+	columns = append(columns,
+		"COALESCE(SUM(txs_count), 0)",
+		"COALESCE(SUM(gas_limit), 0)",
+		"COALESCE(SUM(gas_used), 0)",
+		"COALESCE(SUM(fee_paid), 0)",
+	)
+
+	builder := s.psql.Select(columns...).
+		FromSelect(fees, "f").
+		GroupBy(groupColumns...)
+
+	builder = page(builder, filter.Limit, filter.Offset)
+
+	if filter.GroupBy == repository.FeeGroupByToken {
+		builder = builder.OrderBy("fee_token")
+	} else {
+		builder = builder.OrderBy(groupColumns[0]+" DESC", "fee_token")
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetFeeStats")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetFeeStats")
+	}
+	defer rows.Close()
+
+	var result []repository.FeeStats
+	for rows.Next() {
+		var (
+			stats   repository.FeeStats
+			day     string
+			feePaid float64
+		)
+
+		dest := []any{&stats.FeeToken, &stats.TxsCount, &stats.GasLimit, &stats.GasUsed, &feePaid}
+		switch filter.GroupBy {
+		case repository.FeeGroupByBlock:
+			dest = append([]any{&stats.Height}, dest...)
+		case repository.FeeGroupByDay:
+			dest = append([]any{&day}, dest...)
+		}
+
+		if err = rows.Scan(dest...); err != nil {
+			return nil, errors.New(err, "Scan result for GetFeeStats")
+		}
+
+		// Fees are stored as text and summed as floats, days are returned as YYYY-MM-DD text
+		stats.FeePaid = strconv.FormatFloat(feePaid, 'f', -1, 64)
+		if filter.GroupBy == repository.FeeGroupByDay {
+			if stats.Day, err = time.Parse(time.DateOnly, day); err != nil {
+				return nil, errors.New(err, "Parse day for GetFeeStats")
+			}
+		}
+		result = append(result, stats)
+	}
+
+	return result, nil
+}
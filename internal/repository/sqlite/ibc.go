@@ -0,0 +1,69 @@
+package sqlite
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var ibcTransfersColumns = []string{"tx_hash", "block_height", "tx_pos", "msg_type", "source_port", "source_channel", "destination_port",
+	"destination_channel", "sequence", "denom", "amount", "sender", "receiver", "memo"}
+
+func (s *sqlite) AddIbcTransfers(ctx context.Context, transfers ...repository.IbcTransfer) error {
+	rows := make([][]any, 0, len(transfers))
+	for _, t := range transfers {
+		rows = append(rows, []any{t.TxHash, t.BlockHeight, t.TxPos, t.MsgType, t.SourcePort, t.SourceChannel, t.DestinationPort,
+			t.DestinationChannel, t.Sequence, t.Denom, t.Amount, t.Sender, t.Receiver, t.Memo})
+	}
+
+	return s.insertRows(ctx, "AddIbcTransfers", ibcTransfersTable, ibcTransfersColumns, rows)
+}
+
+func (s *sqlite) GetIbcTransfers(ctx context.Context, filter repository.IbcTransferFilter) ([]repository.IbcTransfer, error) {
+	builder := s.psql.Select(ibcTransfersColumns...).
+		From(ibcTransfersTable).
+		OrderBy("block_height DESC", "tx_pos DESC")
+
+	if filter.Channel != "" {
+		builder = builder.Where(sq.Or{sq.Eq{"source_channel": filter.Channel}, sq.Eq{"destination_channel": filter.Channel}})
+	}
+	if filter.Denom != "" {
+		builder = builder.Where(sq.Eq{"denom": filter.Denom})
+	}
+	builder = page(builder, filter.Limit, filter.Offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetIbcTransfers")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetIbcTransfers")
+	}
+	defer rows.Close()
+
+	var transfers []repository.IbcTransfer
+	for rows.Next() {
+		var t repository.IbcTransfer
+		if err = rows.Scan(&t.TxHash, &t.BlockHeight, &t.TxPos, &t.MsgType, &t.SourcePort, &t.SourceChannel, &t.DestinationPort,
+			&t.DestinationChannel, &t.Sequence, &t.Denom, &t.Amount, &t.Sender, &t.Receiver, &t.Memo); err != nil {
+			return nil, errors.New(err, "Scan result for GetIbcTransfers")
+		}
+		transfers = append(transfers, t)
+	}
+
+	return transfers, nil
+}
+
+func (s *sqlite) DeleteIbcTransfers(ctx context.Context, txHash []byte) error {
+	query, args, err := s.psql.Delete(ibcTransfersTable).Where(sq.Eq{"tx_hash": txHash}).ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for DeleteIbcTransfers")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for DeleteIbcTransfers")
+}
@@ -0,0 +1,68 @@
+package sqlite
+
+import (
+	"context"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+var maspAssetsColumns = []string{"asset_type", "token", "denom", "position", "epoch"}
+
+func (s *sqlite) AddMaspAssets(ctx context.Context, assets ...repository.MaspAsset) error {
+	if len(assets) == 0 {
+		return nil
+	}
+
+	chunkSize := maxQueryParams / len(maspAssetsColumns)
+	for start := 0; start < len(assets); start += chunkSize {
+		builder := s.psql.Insert(maspAssetsTable).Columns(maspAssetsColumns...)
+		for _, a := range assets[start:min(start+chunkSize, len(assets))] {
+			builder = builder.Values(a.AssetType, a.Token, a.Denom, a.Position, a.Epoch)
+		}
+
+		query, args, err := builder.Suffix("ON CONFLICT (asset_type) DO NOTHING").ToSql()
+		if err != nil {
+			return errors.New(err, "Build SQL for AddMaspAssets")
+		}
+
+		if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+			return errors.New(err, "Exec SQL for AddMaspAssets")
+		}
+	}
+
+	return nil
+}
+
+func (s *sqlite) GetMaspAssets(ctx context.Context, assetTypes ...[]byte) ([]repository.MaspAsset, error) {
+	builder := s.psql.Select(maspAssetsColumns...).
+		From(maspAssetsTable).
+		OrderBy("asset_type")
+
+	if len(assetTypes) != 0 {
+		builder = builder.Where(sq.Eq{"asset_type": assetTypes})
+	}
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetMaspAssets")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetMaspAssets")
+	}
+	defer rows.Close()
+
+	var assets []repository.MaspAsset
+	for rows.Next() {
+		var a repository.MaspAsset
+		if err = rows.Scan(&a.AssetType, &a.Token, &a.Denom, &a.Position, &a.Epoch); err != nil {
+			return nil, errors.New(err, "Scan result for GetMaspAssets")
+		}
+		assets = append(assets, a)
+	}
+
+	return assets, nil
+}
@@ -0,0 +1,264 @@
+package sqlite
+
+import (
+	"context"
+	"embed"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+//go:embed migrations/*.sql
+var migrationFiles embed.FS
+
+type migration struct {
+	version int64
+	name    string
+	up      string
+	down    string
+}
+
+// loadMigrations reads migrations named <version>_<name>.up.sql and <version>_<name>.down.sql
+// ordered by version.
+func loadMigrations() ([]migration, error) {
+	entries, err := migrationFiles.ReadDir("migrations")
+	if err != nil {
+		return nil, errors.New(err, "Read migrations dir")
+	}
+
+	byVersion := make(map[int64]*migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+
+		base, direction := strings.TrimSuffix(fileName, ".sql"), ""
+		switch {
+		case strings.HasSuffix(base, ".up"):
+			base, direction = strings.TrimSuffix(base, ".up"), "up"
+		case strings.HasSuffix(base, ".down"):
+			base, direction = strings.TrimSuffix(base, ".down"), "down"
+		default:
+			return nil, errors.Create("Invalid migration file name " + fileName)
+		}
+
+		versionStr, name, ok := strings.Cut(base, "_")
+		if !ok {
+			return nil, errors.Create("Invalid migration file name " + fileName)
+		}
+		version, err := strconv.ParseInt(versionStr, 10, 64)
+		if err != nil {
+			return nil, errors.New(err, "Parse migration version "+fileName)
+		}
+
+		content, err := migrationFiles.ReadFile(path.Join("migrations", fileName))
+		if err != nil {
+			return nil, errors.New(err, "Read migration "+fileName)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &migration{version: version, name: name}
+			byVersion[version] = m
+		} else if m.name != name {
+			return nil, errors.Create(fmt.Sprintf("Migration %d has different names: %s and %s", version, m.name, name))
+		}
+		if direction == "up" {
+			m.up = string(content)
+		} else {
+			m.down = string(content)
+		}
+	}
+
+	migrations := make([]migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.up == "" {
+			return nil, errors.Create(fmt.Sprintf("Migration %d_%s has no up script", m.version, m.name))
+		}
+		migrations = append(migrations, *m)
+	}
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].version < migrations[j].version })
+
+	return migrations, nil
+}
+
+// appliedMigrations creates the schema migrations table and returns applied migrations. Every migration
+// runs in an immediate transaction, so concurrent processes apply them one by one.
+func (s *sqlite) appliedMigrations(ctx context.Context) (map[int64]repository.Migration, error) {
+	if s.db == nil {
+		return nil, errors.Create("Can't run migrations inside of transaction")
+	}
+
+	_, err := s.db.ExecContext(ctx, fmt.Sprintf(`
+	CREATE TABLE IF NOT EXISTS %s (
+		version INTEGER PRIMARY KEY,
+		name TEXT NOT NULL,
+		applied_at TIMESTAMP NOT NULL
+	);`, schemaMigrationsTable))
+	if err != nil {
+		return nil, errors.New(err, "Create schema migrations table")
+	}
+
+	return getAppliedMigrations(ctx, s.db)
+}
+
+func getAppliedMigrations(ctx context.Context, exec executor) (map[int64]repository.Migration, error) {
+	rows, err := exec.QueryContext(ctx, "SELECT version, name, applied_at FROM "+schemaMigrationsTable)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for getAppliedMigrations")
+	}
+	defer rows.Close()
+
+	applied := make(map[int64]repository.Migration)
+	for rows.Next() {
+		var m repository.Migration
+		var appliedAt time.Time
+		if err = rows.Scan(&m.Version, &m.Name, &appliedAt); err != nil {
+			return nil, errors.New(err, "Scan result for getAppliedMigrations")
+		}
+		m.AppliedAt = &appliedAt
+		applied[m.Version] = m
+	}
+
+	return applied, errors.New(rows.Err(), "Read rows for getAppliedMigrations")
+}
+
+// runMigration executes migration script and updates schema_migrations in one database transaction
+// if the migration is still in the expected state, so it's skipped if another process has run it already.
+func (s *sqlite) runMigration(ctx context.Context, m migration, up bool) (bool, error) {
+	ran := false
+	err := s.RunInTransaction(ctx, func(ctx context.Context, repo repository.Repository) error {
+		tx := repo.(*sqlite).exec
+
+		applied, err := getAppliedMigrations(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if _, ok := applied[m.version]; ok == up {
+			return nil
+		}
+
+		script := m.up
+		if !up {
+			script = m.down
+		}
+		if _, err = tx.ExecContext(ctx, script); err != nil {
+			return errors.New(err, "Exec migration script")
+		}
+
+		if up {
+			_, err = tx.ExecContext(ctx, "INSERT INTO "+schemaMigrationsTable+" (version, name, applied_at) VALUES (?, ?, ?)",
+				m.version, m.name, time.Now())
+		} else {
+			_, err = tx.ExecContext(ctx, "DELETE FROM "+schemaMigrationsTable+" WHERE version = ?", m.version)
+		}
+		if err != nil {
+			return errors.New(err, "Exec SQL for runMigration")
+		}
+
+		ran = true
+		return nil
+	})
+
+	return ran, err
+}
+
+func (s *sqlite) MigrateUp(ctx context.Context) (int, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return 0, err
+	}
+
+	appliedMigrations, err := s.appliedMigrations(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, m := range migrations {
+		if _, ok := appliedMigrations[m.version]; ok {
+			continue
+		}
+
+		logger.Info("Applying migration", zap.Int64("version", m.version), zap.String("name", m.name))
+
+		ran, err := s.runMigration(ctx, m, true)
+		if err != nil {
+			return applied, errors.New(err, fmt.Sprintf("Apply migration %d_%s", m.version, m.name))
+		}
+		if ran {
+			applied++
+		}
+	}
+
+	return applied, nil
+}
+
+func (s *sqlite) MigrateDown(ctx context.Context, steps int) error {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return err
+	}
+
+	appliedMigrations, err := s.appliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i := len(migrations) - 1; i >= 0 && steps > 0; i-- {
+		m := migrations[i]
+		if _, ok := appliedMigrations[m.version]; !ok {
+			continue
+		}
+		if m.down == "" {
+			return errors.Create(fmt.Sprintf("Migration %d_%s can't be reverted", m.version, m.name))
+		}
+
+		logger.Info("Reverting migration", zap.Int64("version", m.version), zap.String("name", m.name))
+
+		if _, err = s.runMigration(ctx, m, false); err != nil {
+			return errors.New(err, fmt.Sprintf("Revert migration %d_%s", m.version, m.name))
+		}
+		steps--
+	}
+
+	return nil
+}
+
+// MigrationStatus returns all known migrations ordered by version, AppliedAt is nil for pending ones.
+// Applied migrations unknown to this binary are included too.
+func (s *sqlite) MigrationStatus(ctx context.Context) ([]repository.Migration, error) {
+	migrations, err := loadMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	appliedMigrations, err := s.appliedMigrations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var status []repository.Migration
+	for _, m := range migrations {
+		if applied, ok := appliedMigrations[m.version]; ok {
+			status = append(status, applied)
+			delete(appliedMigrations, m.version)
+		} else {
+			status = append(status, repository.Migration{Version: m.version, Name: m.name})
+		}
+	}
+	for _, applied := range appliedMigrations {
+		status = append(status, applied)
+	}
+
+	sort.Slice(status, func(i, j int) bool { return status[i].Version < status[j].Version })
+
+	return status, nil
+}
@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS pruned_shielded;
+DROP TABLE IF EXISTS pruned_account_totals;
+DROP TABLE IF EXISTS indexer_status;
+DROP TABLE IF EXISTS block_events;
+DROP TABLE IF EXISTS failed_txs;
+DROP TABLE IF EXISTS raw_txs;
+DROP TABLE IF EXISTS account_transactions;
+DROP TABLE IF EXISTS commit_signatures;
+DROP TABLE IF EXISTS evidences;
+DROP TABLE IF EXISTS transactions;
+DROP TABLE IF EXISTS blocks;
@@ -0,0 +1,142 @@
+CREATE TABLE IF NOT EXISTS blocks (
+	block_id BLOB NOT NULL PRIMARY KEY,
+	header_version_app INTEGER NOT NULL,
+	header_version_block INTEGER NOT NULL,
+	header_chain_id TEXT NOT NULL,
+	header_height INTEGER NOT NULL,
+	header_time TIMESTAMP NOT NULL,
+	header_last_block_id_hash BLOB,
+	header_last_block_id_parts_header_total INTEGER,
+	header_last_block_id_parts_header_hash BLOB,
+	header_last_commit_hash BLOB,
+	header_data_hash BLOB,
+	header_validators_hash BLOB NOT NULL,
+	header_next_validators_hash BLOB NOT NULL,
+	header_consensus_hash BLOB NOT NULL,
+	header_app_hash BLOB NOT NULL,
+	header_last_results_hash BLOB,
+	header_evidence_hash BLOB,
+	header_proposer_address BLOB NOT NULL,
+	commit_height INTEGER,
+	commit_round INTEGER,
+	commit_block_id_hash BLOB,
+	commit_block_id_parts_header_total INTEGER,
+	commit_block_id_parts_header_hash BLOB
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS blocks_header_height_unique ON blocks (header_height);
+CREATE INDEX IF NOT EXISTS blocks_header_time_idx ON blocks (header_time);
+
+CREATE TABLE IF NOT EXISTS transactions (
+	hash BLOB NOT NULL,
+	block_id BLOB NOT NULL REFERENCES blocks (block_id),
+	tx_type TEXT NOT NULL,
+	wrapper_id BLOB,
+	memo TEXT,
+	fee_amount_per_gas_unit TEXT,
+	fee_token TEXT,
+	gas_limit_multiplier INTEGER,
+	code BLOB,
+	data TEXT,
+	return_code INTEGER,
+	pos_in_block INTEGER NOT NULL,
+	gas_used INTEGER,
+	fee_paid TEXT,
+	block_height INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS transactions_block_id_idx ON transactions (block_id);
+CREATE INDEX IF NOT EXISTS transactions_hash_idx ON transactions (hash);
+CREATE INDEX IF NOT EXISTS transactions_memo_idx ON transactions (memo) WHERE memo IS NOT NULL;
+CREATE INDEX IF NOT EXISTS transactions_wrapper_id_idx ON transactions (wrapper_id) WHERE wrapper_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS transactions_block_height_idx ON transactions (block_height);
+
+CREATE TABLE IF NOT EXISTS evidences (
+	block_id BLOB NOT NULL,
+	height INTEGER NOT NULL,
+	time INTEGER NOT NULL,
+	address BLOB,
+	total_voting_power INTEGER NOT NULL,
+	validator_power INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS commit_signatures (
+	block_id BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	block_id_flag INTEGER NOT NULL,
+	validator_address BLOB NOT NULL,
+	timestamp INTEGER NOT NULL,
+	signature BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS commit_signatures_block_idx ON commit_signatures (block_id);
+CREATE INDEX IF NOT EXISTS commit_signatures_validator_height_idx ON commit_signatures (validator_address, block_height);
+
+CREATE TABLE IF NOT EXISTS account_transactions (
+	address BLOB NOT NULL,
+	tx_hash BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	tx_pos INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS account_transactions_address_idx ON account_transactions (address);
+
+CREATE TABLE IF NOT EXISTS raw_txs (
+	tx_hash BLOB NOT NULL,
+	block_id BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	tx_pos INTEGER NOT NULL,
+	data BLOB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS raw_txs_tx_hash_idx ON raw_txs (tx_hash);
+CREATE INDEX IF NOT EXISTS raw_txs_block_height_idx ON raw_txs (block_height);
+
+CREATE TABLE IF NOT EXISTS failed_txs (
+	block_id BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	tx_pos INTEGER NOT NULL,
+	tx_hash BLOB,
+	data BLOB NOT NULL,
+	error TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS failed_txs_block_height_idx ON failed_txs (block_height);
+
+CREATE TABLE IF NOT EXISTS block_events (
+	block_id BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	kind TEXT NOT NULL,
+	event_pos INTEGER NOT NULL,
+	type TEXT NOT NULL,
+	tx_hash BLOB,
+	attributes TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS block_events_type_height_idx ON block_events (type, block_height);
+CREATE INDEX IF NOT EXISTS block_events_block_height_idx ON block_events (block_height);
+CREATE INDEX IF NOT EXISTS block_events_tx_hash_idx ON block_events (tx_hash) WHERE tx_hash IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS indexer_status (
+	id INTEGER PRIMARY KEY DEFAULT 1 CHECK (id = 1),
+	indexed_height INTEGER NOT NULL,
+	chain_height INTEGER NOT NULL,
+	last_block_time TIMESTAMP,
+	sync_state TEXT NOT NULL,
+	version TEXT NOT NULL,
+	checksums TEXT NOT NULL,
+	error_count INTEGER NOT NULL,
+	updated_at TIMESTAMP NOT NULL
+);
+
+-- Aggregates of pruned rows which are added to totals calculated from remaining rows
+CREATE TABLE IF NOT EXISTS pruned_account_totals (
+	address BLOB PRIMARY KEY,
+	txs_count INTEGER NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS pruned_shielded (
+	token TEXT PRIMARY KEY,
+	amount REAL NOT NULL
+);
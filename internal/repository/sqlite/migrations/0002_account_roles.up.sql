@@ -0,0 +1,2 @@
+-- Every address involved in a tx is saved with its role, rows saved before have an empty role until reindexed
+ALTER TABLE account_transactions ADD COLUMN role TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,23 @@
+-- Token transfers of IBC messages, destination and sequence are empty for sent transfers
+CREATE TABLE IF NOT EXISTS ibc_transfers (
+	tx_hash BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	tx_pos INTEGER NOT NULL,
+	msg_type TEXT NOT NULL,
+	source_port TEXT NOT NULL,
+	source_channel TEXT NOT NULL,
+	destination_port TEXT NOT NULL,
+	destination_channel TEXT NOT NULL,
+	sequence INTEGER NOT NULL,
+	denom TEXT NOT NULL,
+	amount TEXT NOT NULL,
+	sender TEXT NOT NULL,
+	receiver TEXT NOT NULL,
+	memo TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS ibc_transfers_tx_hash_idx ON ibc_transfers (tx_hash);
+CREATE INDEX IF NOT EXISTS ibc_transfers_block_height_idx ON ibc_transfers (block_height);
+CREATE INDEX IF NOT EXISTS ibc_transfers_source_channel_idx ON ibc_transfers (source_channel);
+CREATE INDEX IF NOT EXISTS ibc_transfers_destination_channel_idx ON ibc_transfers (destination_channel);
+CREATE INDEX IF NOT EXISTS ibc_transfers_denom_idx ON ibc_transfers (denom);
@@ -0,0 +1,17 @@
+-- MASP transactions of txs with counts of shielded descriptions, value balance and transparent inputs and outputs
+CREATE TABLE IF NOT EXISTS masp_txs (
+	tx_hash BLOB NOT NULL,
+	block_height INTEGER NOT NULL,
+	tx_pos INTEGER NOT NULL,
+	masp_pos INTEGER NOT NULL,
+	expiry_height INTEGER NOT NULL,
+	spends_count INTEGER NOT NULL,
+	converts_count INTEGER NOT NULL,
+	outputs_count INTEGER NOT NULL,
+	value_balance TEXT NOT NULL,
+	transparent_inputs TEXT NOT NULL,
+	transparent_outputs TEXT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS masp_txs_tx_hash_idx ON masp_txs (tx_hash);
+CREATE INDEX IF NOT EXISTS masp_txs_block_height_idx ON masp_txs (block_height);
@@ -0,0 +1,10 @@
+-- Asset data of MASP asset types: token, denomination, digit position and epoch
+CREATE TABLE IF NOT EXISTS masp_assets (
+	asset_type BLOB PRIMARY KEY,
+	token TEXT NOT NULL,
+	denom INTEGER NOT NULL,
+	position INTEGER NOT NULL,
+	epoch INTEGER
+);
+
+CREATE INDEX IF NOT EXISTS masp_assets_token_idx ON masp_assets (token);
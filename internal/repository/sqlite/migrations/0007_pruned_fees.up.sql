@@ -0,0 +1,11 @@
+-- Fee aggregates of pruned wrapper txs per day, fee payer and fee token
+CREATE TABLE IF NOT EXISTS pruned_fees (
+	day TEXT NOT NULL,
+	fee_payer TEXT NOT NULL,
+	fee_token TEXT NOT NULL,
+	txs_count INTEGER NOT NULL,
+	gas_limit INTEGER NOT NULL,
+	gas_used INTEGER NOT NULL,
+	fee_paid REAL NOT NULL,
+	PRIMARY KEY (day, fee_payer, fee_token)
+);
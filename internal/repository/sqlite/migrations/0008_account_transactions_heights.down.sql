@@ -0,0 +1,3 @@
+-- Backfilled account transactions and repaired heights are kept
+DROP INDEX IF EXISTS account_transactions_block_height_idx;
+DROP INDEX IF EXISTS account_transactions_tx_hash_idx;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS failed_txs_block_height_tx_pos_idx;
+
+ALTER TABLE failed_txs DROP COLUMN tx_type;
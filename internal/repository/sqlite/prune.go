@@ -0,0 +1,154 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// PruneBlocks deletes blocks with heights in [fromHeight, toHeight] and all rows related to them.
+// Account txs counts, shielded amounts and fees of deleted txs are added to pruned aggregates before.
+func (s *sqlite) PruneBlocks(ctx context.Context, fromHeight, toHeight int64, maspAddress string) error {
+	query, args, err := s.psql.Insert(prunedAccountTotalsTable).
+		Columns("address", "txs_count").
+		Select(s.psql.Select("address", "COUNT(DISTINCT tx_hash)").
+			From(accountTransactionsTable).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			GroupBy("address")).
+		Suffix("ON CONFLICT (address) DO UPDATE SET txs_count = " + prunedAccountTotalsTable + ".txs_count + EXCLUDED.txs_count").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks account totals")
+	}
+	if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks account totals")
+	}
+
+	// Same as shielded amounts calculated from transfers by the service, amounts which are not numbers are skipped
+	query, args, err = s.psql.Insert(prunedShieldedTable).
+		Columns("token", "amount").
+		Select(s.psql.Select("COALESCE(data ->> 'token', '')").
+			Column(sq.Expr("SUM(CASE WHEN data ->> 'target' = ? THEN CAST(data ->> 'amount' AS REAL) ELSE -CAST(data ->> 'amount' AS REAL) END)", maspAddress)).
+			From(transactionsTable).
+			Where(sq.GtOrEq{"block_height": fromHeight}).
+			Where(sq.LtOrEq{"block_height": toHeight}).
+			Where(sq.Eq{"tx_type": "Decrypted"}).
+			Where(sq.Expr("(data ->> 'source' = ?) <> (data ->> 'target' = ?)", maspAddress, maspAddress)).
+			// Digits with an optional fractional part, SQLite has no regular expressions
+			Where("data ->> 'amount' GLOB '[0-9]*'").
+			Where("data ->> 'amount' NOT GLOB '*[^0-9.]*'").
+			Where("data ->> 'amount' NOT GLOB '*.*.*'").
+			Where("data ->> 'amount' NOT GLOB '*.'").
+			GroupBy("COALESCE(data ->> 'token', '')")).
+		Suffix("ON CONFLICT (token) DO UPDATE SET amount = " + prunedShieldedTable + ".amount + EXCLUDED.amount").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks shielded")
+	}
+	if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks shielded")
+	}
+
+	// Same as fee stats calculated by GetFeeStats, grouped by day to keep stats by day
+	fees := s.psql.Select(
+		"date(b.header_time)",
+		"w.fee_payer",
+		"COALESCE(w.fee_token, '')",
+		"COUNT(*)",
+		"COALESCE(SUM(w.gas_limit_multiplier), 0)",
+		"COALESCE(SUM(COALESCE(d.gas_used, w.gas_used)), 0)",
+		"COALESCE(SUM(w.fee_paid), 0)",
+	).
+		From(transactionsTable+" w").
+		Join(blocksTable+" b USING (block_id)").
+		LeftJoin(transactionsTable+" d ON d.wrapper_id = w.hash AND d.block_height = w.block_height + 1").
+		Where("w.fee_paid IS NOT NULL").
+		Where(sq.GtOrEq{"w.block_height": fromHeight}).
+		Where(sq.LtOrEq{"w.block_height": toHeight}).
+		GroupBy("date(b.header_time)", "w.fee_payer", "COALESCE(w.fee_token, '')")
+
+	query, args, err = s.psql.Insert(prunedFeesTable).
+		Columns("day", "fee_payer", "fee_token", "txs_count", "gas_limit", "gas_used", "fee_paid").
+		Select(fees).
+		Suffix("ON CONFLICT (day, fee_payer, fee_token) DO UPDATE SET " +
+			"txs_count = " + prunedFeesTable + ".txs_count + EXCLUDED.txs_count, " +
+			"gas_limit = " + prunedFeesTable + ".gas_limit + EXCLUDED.gas_limit, " +
+			"gas_used = " + prunedFeesTable + ".gas_used + EXCLUDED.gas_used, " +
+			"fee_paid = " + prunedFeesTable + ".fee_paid + EXCLUDED.fee_paid").
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for PruneBlocks fees")
+	}
+	if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+		return errors.New(err, "Exec SQL for PruneBlocks fees")
+	}
+
+	return s.DeleteBlocks(ctx, fromHeight, toHeight)
+}
+
+func (s *sqlite) GetPrunedShielded(ctx context.Context) ([]repository.TokenAmount, error) {
+	query, args, err := s.psql.Select("token", "amount").From(prunedShieldedTable).ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetPrunedShielded")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetPrunedShielded")
+	}
+	defer rows.Close()
+
+	var amounts []repository.TokenAmount
+	for rows.Next() {
+		var (
+			amount repository.TokenAmount
+			value  float64
+		)
+		if err = rows.Scan(&amount.Token, &value); err != nil {
+			return nil, errors.New(err, "Scan result for GetPrunedShielded")
+		}
+		amount.Amount = strconv.FormatFloat(value, 'f', -1, 64)
+		amounts = append(amounts, amount)
+	}
+
+	return amounts, nil
+}
+
+// GetEarliestHeight returns the lowest stored height, 0 if there are no blocks.
+func (s *sqlite) GetEarliestHeight(ctx context.Context) (int64, error) {
+	query, args, err := s.psql.Select("COALESCE(MIN(header_height), 0)").From(blocksTable).ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetEarliestHeight")
+	}
+
+	var height int64
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&height)
+	return height, errors.New(err, "Exec SQL for GetEarliestHeight")
+}
+
+// GetFirstHeightSince returns the lowest height of blocks created at t or later.
+func (s *sqlite) GetFirstHeightSince(ctx context.Context, t time.Time) (int64, error) {
+	query, args, err := s.psql.Select("MIN(header_height)").
+		From(blocksTable).
+		Where(sq.GtOrEq{"header_time": t.UTC()}).
+		ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetFirstHeightSince")
+	}
+
+	var height sql.NullInt64
+	if err = s.exec.QueryRowContext(ctx, query, args...).Scan(&height); err != nil {
+		return 0, errors.New(err, "Exec SQL for GetFirstHeightSince")
+	}
+	if !height.Valid {
+		return 0, repository.ErrNotFound
+	}
+
+	return height.Int64, nil
+}
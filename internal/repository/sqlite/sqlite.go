@@ -0,0 +1,164 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"math"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.uber.org/zap"
+	_ "modernc.org/sqlite"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+type sqlite struct {
+	config repository.Config
+	db     *sql.DB
+	exec   executor
+	psql   sq.StatementBuilderType
+}
+
+type executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+const (
+	blocksTable              = "blocks"
+	evidencesTable           = "evidences"
+	commitSignaturesTable    = "commit_signatures"
+	transactionsTable        = "transactions"
+	accountTransactionsTable = "account_transactions"
+	rawTxsTable              = "raw_txs"
+	failedTxsTable           = "failed_txs"
+	blockEventsTable         = "block_events"
+	indexerStatusTable       = "indexer_status"
+	schemaMigrationsTable    = "schema_migrations"
+	prunedAccountTotalsTable = "pruned_account_totals"
+	prunedShieldedTable      = "pruned_shielded"
+	prunedFeesTable          = "pruned_fees"
+	ibcTransfersTable        = "ibc_transfers"
+	maspTxsTable             = "masp_txs"
+	maspAssetsTable          = "masp_assets"
+)
+
+// maxQueryParams is the default limit of bind parameters in a single SQLite statement.
+const maxQueryParams = 32766
+
+// NewRepository opens the SQLite database file at config.Path, the file is created if it doesn't exist.
+func NewRepository(ctx context.Context, config repository.Config) (*sqlite, error) {
+	// Transactions take the write lock on begin, so concurrent writers wait for each other
+	// instead of failing on lock upgrade. Times are written in the format understood by SQLite date functions.
+	dsn := "file:" + config.Path + "?_txlock=immediate&_time_format=sqlite&_pragma=busy_timeout(10000)&_pragma=journal_mode(WAL)&_pragma=foreign_keys(1)"
+
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return nil, errors.New(err, "Open sql connection")
+	}
+	if err = db.PingContext(ctx); err != nil {
+		return nil, errors.New(err, "Ping db")
+	}
+
+	return &sqlite{
+		config: config,
+		db:     db,
+		exec:   db,
+		psql:   sq.StatementBuilder.PlaceholderFormat(sq.Question),
+	}, nil
+}
+
+func (s *sqlite) Close() error {
+	if s.db == nil {
+		return nil
+	}
+	return s.db.Close()
+}
+
+func (s *sqlite) RunInTransaction(ctx context.Context, txFunc repository.InTransaction) (err error) {
+	if s.db == nil {
+		return errors.Create("Can't run transaction inside of another transaction")
+	}
+
+	var tx *sql.Tx
+	tx, err = s.db.BeginTx(ctx, &sql.TxOptions{})
+	if err != nil {
+		return errors.New(err, "Begin db tx")
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			rErr := tx.Rollback()
+			logger.Error("Database rollback failed before panic", zap.Error(rErr))
+			panic(p)
+		} else if err != nil {
+			rErr := tx.Rollback()
+			if rErr != nil {
+				err = errors.New(err, rErr.Error())
+			}
+		} else {
+			err = tx.Commit()
+		}
+	}()
+
+	runner := &sqlite{s.config, nil, tx, s.psql}
+	err = txFunc(ctx, runner)
+
+	return
+}
+
+// page applies limit and offset, SQLite doesn't accept OFFSET without LIMIT.
+func page(builder sq.SelectBuilder, limit, offset uint64) sq.SelectBuilder {
+	if limit == 0 {
+		limit = math.MaxInt64
+	}
+	return builder.Limit(limit).Offset(offset)
+}
+
+// insertRows saves rows to the table with multi-VALUES INSERT statements split to fit into parameters limit.
+// name is used in error messages.
+func (s *sqlite) insertRows(ctx context.Context, name, table string, columns []string, rows [][]any) error {
+	// Typed nil slices are not NULL for the driver
+	for _, row := range rows {
+		for i, value := range row {
+			if b, ok := value.([]byte); ok && b == nil {
+				row[i] = nil
+			}
+		}
+	}
+
+	chunkSize := maxQueryParams / len(columns)
+	for start := 0; start < len(rows); start += chunkSize {
+		builder := s.psql.Insert(table).Columns(columns...)
+		for _, row := range rows[start:min(start+chunkSize, len(rows))] {
+			builder = builder.Values(row...)
+		}
+
+		query, args, err := builder.ToSql()
+		if err != nil {
+			return errors.New(err, "Build SQL for "+name)
+		}
+
+		if _, err = s.exec.ExecContext(ctx, query, args...); err != nil {
+			return errors.New(err, "Exec SQL for "+name)
+		}
+	}
+
+	return nil
+}
+
+// jsonValue passes JSON as text, JSON functions don't accept blobs.
+func jsonValue(data []byte) any {
+	if data == nil {
+		return nil
+	}
+	return string(data)
+}
+
+// EnsurePartitions does nothing, SQLite tables aren't partitioned.
+func (s *sqlite) EnsurePartitions(context.Context, int64) error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package sqlite
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/repository/repotest"
+)
+
+func TestRepository(t *testing.T) {
+	repotest.Run(t, func(t *testing.T) repository.Repository {
+		ctx := context.Background()
+
+		repo, err := NewRepository(ctx, repository.Config{Driver: repository.DriverSQLite, Path: filepath.Join(t.TempDir(), "test.db")})
+		if err != nil {
+			t.Fatalf("NewRepository: %v", err)
+		}
+		if _, err = repo.MigrateUp(ctx); err != nil {
+			t.Fatalf("MigrateUp: %v", err)
+		}
+		return repo
+	})
+}
@@ -0,0 +1,220 @@
+package sqlite
+
+import (
+	"context"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+func (s *sqlite) AddTransactions(ctx context.Context, txs ...repository.Transaction) error {
+	rows := make([][]any, 0, len(txs))
+	for _, tx := range txs {
+		rows = append(rows, []any{tx.Hash, tx.BlockID, tx.TxType, tx.WrapperID, tx.Memo, tx.FeeAmountPerGasUnit, tx.FeeToken, tx.GasLimitMultiplier, tx.Code, jsonValue(tx.Data), tx.ReturnCode, tx.PosInBlock, tx.GasUsed, tx.FeePaid, tx.BlockHeight, tx.FeePayer})
+	}
+
+	return s.insertRows(ctx, "AddTransactions", transactionsTable, []string{"hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block", "gas_used", "fee_paid", "block_height", "fee_payer"}, rows)
+}
+
+func (s *sqlite) GetTotalTxsBy(ctx context.Context, filter repository.TxFilter) (uint64, error) {
+	builder := s.psql.Select("COUNT(*)").From(transactionsTable)
+
+	if len(filter.Hashes) != 0 {
+		builder = builder.Where(sq.Eq{"hash": filter.Hashes})
+	}
+	if len(filter.BlockID) != 0 {
+		builder = builder.Where(sq.Eq{"block_id": filter.BlockID})
+	}
+	if filter.Height != 0 {
+		builder = builder.Where(sq.Eq{"block_height": filter.Height})
+	}
+	if filter.Memo != "" {
+		builder = builder.Where(sq.Eq{"memo": filter.Memo})
+	}
+	if filter.TxType != "" {
+		builder = builder.Where(sq.Eq{"tx_type": filter.TxType})
+	}
+	builder = page(builder, filter.Limit, filter.Offset)
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return 0, errors.New(err, "Build SQL for GetTotalTxsBy")
+	}
+
+	var total uint64
+	err = s.exec.QueryRowContext(ctx, query, args...).Scan(&total)
+	if err != nil {
+		return 0, errors.New(err, "Exec SQL for GetTotalTxsBy")
+	}
+
+	return total, nil
+}
+
+func (s *sqlite) GetTxsBy(ctx context.Context, filter repository.TxFilter) ([]repository.Transaction, error) {
+	builder := s.psql.Select("hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block", "gas_used", "fee_paid", "fee_payer", "header_height", "header_time").
+		From(transactionsTable).
+		Join(blocksTable + " USING (block_id)")
+
+	if len(filter.Hashes) != 0 {
+		builder = builder.Where(sq.Eq{"hash": filter.Hashes})
+	}
+	if len(filter.BlockID) != 0 {
+		builder = builder.Where(sq.Eq{"block_id": filter.BlockID})
+	}
+	if filter.Height != 0 {
+		builder = builder.Where(sq.Eq{"block_height": filter.Height})
+	}
+	if filter.Memo != "" {
+		builder = builder.Where(sq.Eq{"memo": filter.Memo})
+	}
+	if filter.TxType != "" {
+		builder = builder.Where(sq.Eq{"tx_type": filter.TxType})
+	}
+	builder = page(builder, filter.Limit, filter.Offset)
+
+	builder = builder.OrderBy("header_height DESC", "pos_in_block DESC")
+
+	query, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetTxsBy")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetTxsBy: "+query)
+	}
+	defer rows.Close()
+
+	var txs []repository.Transaction
+	for rows.Next() {
+		var tx repository.Transaction
+		if err = rows.Scan(&tx.Hash, &tx.BlockID, &tx.TxType, &tx.WrapperID, &tx.Memo,
+			&tx.FeeAmountPerGasUnit, &tx.FeeToken, &tx.GasLimitMultiplier, &tx.Code, &tx.Data, &tx.ReturnCode, &tx.PosInBlock,
+			&tx.GasUsed, &tx.FeePaid, &tx.FeePayer, &tx.BlockHeight, &tx.BlockTime); err != nil {
+			return nil, errors.New(err, "Scan result for GetTxsBy")
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (s *sqlite) GetTxsBySourceOrTarget(ctx context.Context, address string) ([]repository.Transaction, error) {
+	query, args, err := s.psql.Select("hash", "block_id", "tx_type", "wrapper_id", "memo", "fee_amount_per_gas_unit", "fee_token", "gas_limit_multiplier", "code", "data", "return_code", "pos_in_block").
+		From(transactionsTable).
+		Where(sq.Eq{"tx_type": "Decrypted"}).
+		Where(sq.Or{sq.Eq{"data ->> 'source'": address}, sq.Eq{"data ->> 'target'": address}}).
+		Where(sq.Expr("(hash, block_height) IN (SELECT tx_hash, block_height FROM "+accountTransactionsTable+" WHERE address = ? AND role IN (?, ?))",
+			[]byte(address), repository.RoleSource, repository.RoleTarget)).
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetTxsBySourceOrTarget")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetTxsBySourceOrTarget")
+	}
+	defer rows.Close()
+
+	var txs []repository.Transaction
+	for rows.Next() {
+		var tx repository.Transaction
+		if err = rows.Scan(&tx.Hash, &tx.BlockID, &tx.TxType, &tx.WrapperID, &tx.Memo,
+			&tx.FeeAmountPerGasUnit, &tx.FeeToken, &tx.GasLimitMultiplier, &tx.Code, &tx.Data, &tx.ReturnCode, &tx.PosInBlock); err != nil {
+			return nil, errors.New(err, "Scan result for GetTxsBySourceOrTarget")
+		}
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
+}
+
+func (s *sqlite) GetVoteProposalDatas(ctx context.Context, voteCodes [][]byte, proposalID int64) ([]json.RawMessage, error) {
+	query, args, err := s.psql.Select("data").
+		From(transactionsTable).
+		Join(blocksTable+" USING (block_id)").
+		Where(sq.Eq{"code": voteCodes}).
+		Where(sq.Eq{"CAST(data ->> 'id' AS INTEGER)": proposalID}).
+		OrderBy("header_height DESC", "pos_in_block DESC").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetVoteProposalDatas")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetVoteProposalDatas")
+	}
+	defer rows.Close()
+
+	var datas []json.RawMessage
+	for rows.Next() {
+		var data []byte
+		if err = rows.Scan(&data); err != nil {
+			return nil, errors.New(err, "Scan result for GetVoteProposalDatas")
+		}
+		datas = append(datas, json.RawMessage(data))
+	}
+
+	return datas, nil
+}
+
+func (s *sqlite) GetHeightsWithoutWrapperIDs(ctx context.Context) ([]int64, error) {
+	query, args, err := s.psql.Select("DISTINCT block_height").
+		From(transactionsTable).
+		Where(sq.Eq{"tx_type": "Decrypted"}).
+		Where(sq.Eq{"wrapper_id": nil}).
+		OrderBy("block_height").
+		ToSql()
+	if err != nil {
+		return nil, errors.New(err, "Build SQL for GetHeightsWithoutWrapperIDs")
+	}
+
+	rows, err := s.exec.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, errors.New(err, "Exec SQL for GetHeightsWithoutWrapperIDs")
+	}
+	defer rows.Close()
+
+	var heights []int64
+	for rows.Next() {
+		var height int64
+		if err = rows.Scan(&height); err != nil {
+			return nil, errors.New(err, "Scan result for GetHeightsWithoutWrapperIDs")
+		}
+		heights = append(heights, height)
+	}
+
+	return heights, nil
+}
+
+func (s *sqlite) UpdateWrapperID(ctx context.Context, blockHeight int64, txHash, wrapperID []byte) error {
+	query, args, err := s.psql.Update(transactionsTable).
+		Set("wrapper_id", wrapperID).
+		Where(sq.Eq{"block_height": blockHeight}).
+		Where(sq.Eq{"hash": txHash}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for UpdateWrapperID")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for UpdateWrapperID")
+}
+
+func (s *sqlite) UpdateTxData(ctx context.Context, blockHeight int64, txHash []byte, data []byte) error {
+	query, args, err := s.psql.Update(transactionsTable).
+		Set("data", jsonValue(data)).
+		Where(sq.Eq{"block_height": blockHeight}).
+		Where(sq.Eq{"hash": txHash}).
+		ToSql()
+	if err != nil {
+		return errors.New(err, "Build SQL for UpdateTxData")
+	}
+
+	_, err = s.exec.ExecContext(ctx, query, args...)
+	return errors.New(err, "Exec SQL for UpdateTxData")
+}
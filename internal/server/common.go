@@ -20,6 +20,10 @@ func (s *Server) getQueryInt64(r *http.Request, name string) int64 {
 	return i64
 }
 
+func (s *Server) getQueryString(r *http.Request, name string) string {
+	return r.URL.Query().Get(name)
+}
+
 func (s *Server) getPathInt64(r *http.Request, name string) int64 {
 	value, ok := mux.Vars(r)[name]
 	if !ok {
@@ -65,6 +69,11 @@ type responseWriter struct {
 	}
 }
 
+func (rw *responseWriter) WriteHeader(statusCode int) {
+	rw.response.code = statusCode
+	rw.ResponseWriter.WriteHeader(statusCode)
+}
+
 func (s *Server) writeResult(w http.ResponseWriter, result interface{}, err error) {
 	statusCode := s.getStatusCode(err)
 
@@ -85,3 +94,18 @@ func (s *Server) writeResult(w http.ResponseWriter, result interface{}, err erro
 
 	w.WriteHeader(statusCode)
 }
+
+// writeResultWithStatus writes the result with the given status code, unlike writeResult the body is written
+// for error codes too.
+func (s *Server) writeResultWithStatus(w http.ResponseWriter, statusCode int, result interface{}) {
+	if rw, ok := w.(*responseWriter); ok {
+		rw.response.result = result
+		rw.response.code = statusCode
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		logger.Error("Failed to encode response to JSON", zap.Error(err))
+	}
+}
@@ -2,4 +2,9 @@ package server
 
 type Config struct {
 	Port string
+	// HealthMaxLag is the maximum indexer lag in blocks at which /health reports healthy, 0 disables the check
+	HealthMaxLag int64
+	// HealthStaleIntervals is the number of indexer status intervals after which /health reports a status
+	// which isn't updated as unhealthy, stopped and failed indexers are unhealthy too. 0 disables the check
+	HealthStaleIntervals int64
 }
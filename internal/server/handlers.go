@@ -55,7 +55,7 @@ func (s *Server) txsByHashes(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := s.service.GetTxsByHashes(r.Context(), hashes...)
+	result, err := s.service.GetTxsByHashes(r.Context(), s.getQueryInt64(r, "height"), hashes...)
 
 	s.writeResult(w, result, err)
 }
@@ -67,7 +67,7 @@ func (s *Server) txByHash(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := s.service.GetTxsByHashes(r.Context(), hash)
+	result, err := s.service.GetTxsByHashes(r.Context(), s.getQueryInt64(r, "height"), hash)
 	if err != nil {
 		s.writeResult(w, nil, err)
 		return
@@ -106,6 +106,14 @@ func (s *Server) txsByMemoTotal(w http.ResponseWriter, r *http.Request) {
 	s.writeResult(w, result, err)
 }
 
+func (s *Server) txsFailed(w http.ResponseWriter, r *http.Request) {
+	limit, offset := s.getQueryInt64(r, "limit"), s.getQueryInt64(r, "offset")
+
+	result, err := s.service.GetFailedTxs(r.Context(), limit, offset)
+
+	s.writeResult(w, result, err)
+}
+
 func (s *Server) txVoteProposal(w http.ResponseWriter, r *http.Request) {
 	proposalID := s.getPathInt64(r, "proposal_id")
 	if proposalID == -1 {
@@ -124,6 +132,110 @@ func (s *Server) txShielded(w http.ResponseWriter, r *http.Request) {
 	s.writeResult(w, result, err)
 }
 
+func (s *Server) getFeeFilter(r *http.Request) service.FeeFilter {
+	return service.FeeFilter{
+		FeeToken:   s.getQueryString(r, "token"),
+		FeePayer:   s.getQueryString(r, "payer"),
+		FromHeight: s.getQueryInt64(r, "from"),
+		ToHeight:   s.getQueryInt64(r, "to"),
+		Limit:      s.getQueryInt64(r, "limit"),
+		Offset:     s.getQueryInt64(r, "offset"),
+	}
+}
+
+func (s *Server) feesByBlock(w http.ResponseWriter, r *http.Request) {
+	result, err := s.service.GetFeesByBlock(r.Context(), s.getFeeFilter(r))
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) feesByDay(w http.ResponseWriter, r *http.Request) {
+	result, err := s.service.GetFeesByDay(r.Context(), s.getFeeFilter(r))
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) feesByToken(w http.ResponseWriter, r *http.Request) {
+	result, err := s.service.GetFeesByToken(r.Context(), s.getFeeFilter(r))
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) status(w http.ResponseWriter, r *http.Request) {
+	result, err := s.service.GetStatus(r.Context())
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) health(w http.ResponseWriter, r *http.Request) {
+	result, err := s.service.GetHealth(r.Context(), s.config.HealthMaxLag, s.config.HealthStaleIntervals)
+	if err != nil {
+		s.writeResult(w, nil, err)
+		return
+	}
+
+	statusCode := http.StatusOK
+	if !result.Healthy {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	s.writeResultWithStatus(w, statusCode, result)
+}
+
+func (s *Server) events(w http.ResponseWriter, r *http.Request) {
+	filter := service.EventFilter{
+		Type:       s.getQueryString(r, "type"),
+		Key:        s.getQueryString(r, "key"),
+		Value:      s.getQueryString(r, "value"),
+		TxHash:     s.getQueryString(r, "tx_hash"),
+		FromHeight: s.getQueryInt64(r, "from"),
+		ToHeight:   s.getQueryInt64(r, "to"),
+		Limit:      s.getQueryInt64(r, "limit"),
+		Offset:     s.getQueryInt64(r, "offset"),
+	}
+
+	result, err := s.service.GetEvents(r.Context(), filter)
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) txMasp(w http.ResponseWriter, r *http.Request) {
+	hash := s.getPathString(r, "hash")
+	if hash == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.service.GetMaspTxs(r.Context(), hash)
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) maspAsset(w http.ResponseWriter, r *http.Request) {
+	assetType := s.getPathString(r, "asset_type")
+	if assetType == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	result, err := s.service.GetMaspAsset(r.Context(), assetType)
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) ibcTransfers(w http.ResponseWriter, r *http.Request) {
+	filter := service.IbcTransferFilter{
+		Channel: s.getQueryString(r, "channel"),
+		Denom:   s.getQueryString(r, "denom"),
+		Limit:   s.getQueryInt64(r, "limit"),
+		Offset:  s.getQueryInt64(r, "offset"),
+	}
+
+	result, err := s.service.GetIbcTransfers(r.Context(), filter)
+
+	s.writeResult(w, result, err)
+}
+
 func (s *Server) accountUpdates(w http.ResponseWriter, r *http.Request) {
 	accountID := s.getPathString(r, "account_id")
 	if accountID == "" {
@@ -144,7 +256,7 @@ func (s *Server) accountTxs(w http.ResponseWriter, r *http.Request) {
 	}
 	limit, offset := s.getQueryInt64(r, "limit"), s.getQueryInt64(r, "offset")
 
-	result, err := s.service.GetTxsByAccount(r.Context(), address, limit, offset)
+	result, err := s.service.GetTxsByAccount(r.Context(), address, s.getQueryString(r, "role"), limit, offset)
 
 	s.writeResult(w, result, err)
 }
@@ -156,7 +268,21 @@ func (s *Server) accountTxsTotal(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	result, err := s.service.GetTotalTxsByAccount(r.Context(), address)
+	result, err := s.service.GetTotalTxsByAccount(r.Context(), address, s.getQueryString(r, "role"))
+
+	s.writeResult(w, result, err)
+}
+
+func (s *Server) accountFees(w http.ResponseWriter, r *http.Request) {
+	address := s.getPathString(r, "account_id")
+	if address == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	filter := s.getFeeFilter(r)
+	filter.FeePayer = address
+
+	result, err := s.service.GetFeesByToken(r.Context(), filter)
 
 	s.writeResult(w, result, err)
 }
@@ -4,7 +4,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/gorilla/mux"
+	"github.com/the-laziest/namadexer-go/internal/metrics"
 	"github.com/the-laziest/namadexer-go/pkg/errors"
 	"github.com/the-laziest/namadexer-go/pkg/logger"
 	"go.uber.org/zap"
@@ -36,6 +40,34 @@ func (s *Server) logMiddleware(h http.Handler) http.Handler {
 	})
 }
 
+func (s *Server) metricsMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		writer, ok := w.(*responseWriter)
+		if !ok {
+			writer = &responseWriter{ResponseWriter: w}
+		}
+
+		h.ServeHTTP(writer, r)
+
+		route := r.URL.Path
+		if current := mux.CurrentRoute(r); current != nil {
+			if template, err := current.GetPathTemplate(); err == nil {
+				route = template
+			}
+		}
+
+		code := writer.response.code
+		if code == 0 {
+			code = http.StatusOK
+		}
+
+		metrics.Requests.WithLabelValues(route, strconv.Itoa(code)).Inc()
+		metrics.RequestDuration.WithLabelValues(route).Observe(time.Since(start).Seconds())
+	})
+}
+
 func (s *Server) recovery(h http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
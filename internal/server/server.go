@@ -34,7 +34,7 @@ func New(config Config, service service.Service) *Server {
 }
 
 func (s *Server) init() {
-	s.router.Use(s.logMiddleware, s.recovery)
+	s.router.Use(s.logMiddleware, s.metricsMiddleware, s.recovery)
 
 	methods := []string{http.MethodGet, http.MethodPost}
 
@@ -42,18 +42,29 @@ func (s *Server) init() {
 		path        string
 		handlerFunc func(w http.ResponseWriter, r *http.Request)
 	}{
+		{"/status", s.status},
+		{"/health", s.health},
 		{"/block/height/{height:[0-9]+}", s.blockByHeight},
 		{"/block/hash/{hash}", s.blockByHash},
 		{"/block/last", s.lastBlock},
 		{"/txs", s.txsByHashes},
+		{"/txs/failed", s.txsFailed},
 		{"/txs/memo/{memo}", s.txsByMemo},
 		{"/txs/memo/{memo}/total", s.txsByMemoTotal},
 		{"/tx/vote_proposal/{proposal_id:[0-9]+}", s.txVoteProposal},
 		{"/tx/shielded", s.txShielded},
+		{"/tx/masp/{hash}", s.txMasp},
 		{"/tx/{hash}", s.txByHash},
+		{"/fees/blocks", s.feesByBlock},
+		{"/fees/daily", s.feesByDay},
+		{"/fees/tokens", s.feesByToken},
+		{"/events", s.events},
+		{"/ibc/transfers", s.ibcTransfers},
+		{"/masp/asset/{asset_type}", s.maspAsset},
 		{"/account/updates/{account_id}", s.accountUpdates},
 		{"/account/txs/{account_id}", s.accountTxs},
 		{"/account/txs/{account_id}/total", s.accountTxsTotal},
+		{"/account/fees/{account_id}", s.accountFees},
 		{"/validator/{validator_address}/uptime", s.validatorUptime},
 	}
 
@@ -7,7 +7,12 @@ import (
 )
 
 func (s *service) GetAccountUpdates(ctx context.Context, accountID string) (*AccountUpdates, error) {
-	thresholds, err := s.repo.GetAccountThresholds(ctx, s.checksums["tx_update_account"], accountID)
+	updateAccountCodes, err := s.codeHashes("tx_update_account")
+	if err != nil {
+		return nil, err
+	}
+
+	thresholds, err := s.repo.GetAccountThresholds(ctx, updateAccountCodes, accountID)
 	if err == repository.ErrNotFound {
 		return nil, nil
 	}
@@ -15,7 +20,7 @@ func (s *service) GetAccountUpdates(ctx context.Context, accountID string) (*Acc
 		return nil, err
 	}
 
-	vpCodes, err := s.repo.GetAccountVPCodes(ctx, s.checksums["tx_update_account"], accountID)
+	vpCodes, err := s.repo.GetAccountVPCodes(ctx, updateAccountCodes, accountID)
 	if err == repository.ErrNotFound {
 		return nil, nil
 	}
@@ -23,7 +28,7 @@ func (s *service) GetAccountUpdates(ctx context.Context, accountID string) (*Acc
 		return nil, err
 	}
 
-	publicKeys, err := s.repo.GetAccountPublicKeys(ctx, s.checksums["tx_update_account"], accountID)
+	publicKeys, err := s.repo.GetAccountPublicKeys(ctx, updateAccountCodes, accountID)
 	if err != nil {
 		return nil, err
 	}
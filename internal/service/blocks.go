@@ -48,7 +48,7 @@ func (s *service) GetBlockByHeight(ctx context.Context, height int64) (BlockInfo
 
 	blockInfo := repoBlockToInfo(&block)
 
-	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID})
+	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID, Height: block.HeaderHeight})
 	if err != nil {
 		return BlockInfo{}, err
 	}
@@ -74,7 +74,7 @@ func (s *service) GetBlockByHash(ctx context.Context, hash string) (BlockInfo, e
 
 	blockInfo := repoBlockToInfo(&block)
 
-	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID})
+	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID, Height: block.HeaderHeight})
 	if err != nil {
 		return BlockInfo{}, err
 	}
@@ -107,7 +107,7 @@ func (s *service) GetLatestBlocks(ctx context.Context, limit, offset int64) ([]B
 	for _, block := range blocks {
 		info := repoBlockToInfo(block)
 
-		txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID})
+		txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{BlockID: block.BlockID, Height: block.HeaderHeight})
 		if err != nil {
 			return nil, err
 		}
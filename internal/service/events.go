@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (s *service) GetEvents(ctx context.Context, filter EventFilter) ([]EventInfo, error) {
+	if filter.Value != "" && filter.Key == "" {
+		return nil, ErrBadRequest
+	}
+
+	limit, offset := prepareLimitAndOffset(filter.Limit, filter.Offset)
+
+	rFilter := repository.EventFilter{
+		Type:       filter.Type,
+		Key:        filter.Key,
+		Value:      filter.Value,
+		FromHeight: filter.FromHeight,
+		ToHeight:   filter.ToHeight,
+		Limit:      limit,
+		Offset:     offset,
+	}
+	if filter.TxHash != "" {
+		txHash, err := hexToBytes(filter.TxHash)
+		if err != nil {
+			return nil, err
+		}
+		rFilter.TxHash = txHash
+	}
+
+	events, err := s.repo.GetBlockEvents(ctx, rFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]EventInfo, 0, len(events))
+	for _, event := range events {
+		info := EventInfo{
+			BlockID:     event.BlockID,
+			BlockHeight: event.BlockHeight,
+			Kind:        event.Kind,
+			Type:        event.Type,
+			Attributes:  make([]EventAttribute, 0, len(event.Attributes)),
+		}
+		if len(event.TxHash) > 0 {
+			txHash := Hash(event.TxHash)
+			info.TxHash = &txHash
+		}
+		for _, attr := range event.Attributes {
+			info.Attributes = append(info.Attributes, EventAttribute{Key: attr.Key, Value: attr.Value})
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
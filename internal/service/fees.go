@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (s *service) GetFeesByBlock(ctx context.Context, filter FeeFilter) ([]FeeStats, error) {
+	return s.getFeeStats(ctx, repository.FeeGroupByBlock, filter)
+}
+
+func (s *service) GetFeesByDay(ctx context.Context, filter FeeFilter) ([]FeeStats, error) {
+	return s.getFeeStats(ctx, repository.FeeGroupByDay, filter)
+}
+
+func (s *service) GetFeesByToken(ctx context.Context, filter FeeFilter) ([]FeeStats, error) {
+	return s.getFeeStats(ctx, repository.FeeGroupByToken, filter)
+}
+
+func (s *service) getFeeStats(ctx context.Context, groupBy string, filter FeeFilter) ([]FeeStats, error) {
+	limit, offset := prepareLimitAndOffset(filter.Limit, filter.Offset)
+
+	rStats, err := s.repo.GetFeeStats(ctx, repository.FeeStatsFilter{
+		GroupBy:    groupBy,
+		FeeToken:   filter.FeeToken,
+		FeePayer:   filter.FeePayer,
+		FromHeight: filter.FromHeight,
+		ToHeight:   filter.ToHeight,
+		Limit:      limit,
+		Offset:     offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]FeeStats, 0, len(rStats))
+	for _, rStat := range rStats {
+		stat := FeeStats{
+			FeeToken: rStat.FeeToken,
+			TxsCount: rStat.TxsCount,
+			GasLimit: rStat.GasLimit,
+			GasUsed:  rStat.GasUsed,
+			FeePaid:  rStat.FeePaid,
+		}
+		switch groupBy {
+		case repository.FeeGroupByBlock:
+			stat.Height = &rStat.Height
+		case repository.FeeGroupByDay:
+			day := rStat.Day.Format("2006-01-02")
+			stat.Day = &day
+		}
+		stats = append(stats, stat)
+	}
+
+	return stats, nil
+}
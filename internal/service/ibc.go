@@ -0,0 +1,43 @@
+package service
+
+import (
+	"context"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+)
+
+func (s *service) GetIbcTransfers(ctx context.Context, filter IbcTransferFilter) ([]IbcTransferInfo, error) {
+	limit, offset := prepareLimitAndOffset(filter.Limit, filter.Offset)
+
+	transfers, err := s.repo.GetIbcTransfers(ctx, repository.IbcTransferFilter{
+		Channel: filter.Channel,
+		Denom:   filter.Denom,
+		Limit:   limit,
+		Offset:  offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]IbcTransferInfo, 0, len(transfers))
+	for _, transfer := range transfers {
+		infos = append(infos, IbcTransferInfo{
+			TxHash:             transfer.TxHash,
+			BlockHeight:        transfer.BlockHeight,
+			TxPos:              transfer.TxPos,
+			MsgType:            transfer.MsgType,
+			SourcePort:         transfer.SourcePort,
+			SourceChannel:      transfer.SourceChannel,
+			DestinationPort:    transfer.DestinationPort,
+			DestinationChannel: transfer.DestinationChannel,
+			Sequence:           transfer.Sequence,
+			Denom:              transfer.Denom,
+			Amount:             transfer.Amount,
+			Sender:             transfer.Sender,
+			Receiver:           transfer.Receiver,
+			Memo:               transfer.Memo,
+		})
+	}
+
+	return infos, nil
+}
@@ -12,12 +12,29 @@ type Service interface {
 	GetBlockByHash(ctx context.Context, hash string) (BlockInfo, error)
 	GetLatestBlocks(ctx context.Context, limit, offset int64) ([]BlockInfo, error)
 
-	GetTxsByHashes(ctx context.Context, hashes ...string) ([]TxInfo, error)
+	// GetTxsByHashes looks txs up only in the block at height if it's positive.
+	GetTxsByHashes(ctx context.Context, height int64, hashes ...string) ([]TxInfo, error)
 	GetTxsByMemo(ctx context.Context, memo string, limit, offset int64) ([]TxShort, error)
-	GetTxsByAccount(ctx context.Context, addressHex string, limit, offset int64) ([]Hash, error)
+	GetTxsByAccount(ctx context.Context, addressHex, role string, limit, offset int64) ([]Hash, error)
+
+	GetFailedTxs(ctx context.Context, limit, offset int64) ([]FailedTxInfo, error)
 
 	GetTotalTxsByMemo(ctx context.Context, memo string) (Total, error)
-	GetTotalTxsByAccount(ctx context.Context, addressHex string) (Total, error)
+	GetTotalTxsByAccount(ctx context.Context, addressHex, role string) (Total, error)
+
+	GetFeesByBlock(ctx context.Context, filter FeeFilter) ([]FeeStats, error)
+	GetFeesByDay(ctx context.Context, filter FeeFilter) ([]FeeStats, error)
+	GetFeesByToken(ctx context.Context, filter FeeFilter) ([]FeeStats, error)
+
+	GetEvents(ctx context.Context, filter EventFilter) ([]EventInfo, error)
+
+	GetIbcTransfers(ctx context.Context, filter IbcTransferFilter) ([]IbcTransferInfo, error)
+
+	GetMaspTxs(ctx context.Context, hash string) ([]MaspTxInfo, error)
+	GetMaspAsset(ctx context.Context, assetType string) (MaspAssetInfo, error)
+
+	GetStatus(ctx context.Context) (Status, error)
+	GetHealth(ctx context.Context, maxLag, staleIntervals int64) (Health, error)
 
 	GetShielded(ctx context.Context) (ShieldedAssets, error)
 	GetValidatorsUptime(ctx context.Context, validator string, start, end int64) (Uptime, error)
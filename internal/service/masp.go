@@ -0,0 +1,72 @@
+package service
+
+import (
+	"context"
+)
+
+func (s *service) GetMaspTxs(ctx context.Context, hash string) ([]MaspTxInfo, error) {
+	txHash, err := hexToBytes(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	txs, err := s.repo.GetMaspTxs(ctx, txHash)
+	if err != nil {
+		return nil, err
+	}
+	if len(txs) == 0 {
+		return nil, ErrNotFound
+	}
+
+	infos := make([]MaspTxInfo, 0, len(txs))
+	for _, tx := range txs {
+		info := MaspTxInfo{
+			TxHash:             tx.TxHash,
+			BlockHeight:        tx.BlockHeight,
+			TxPos:              tx.TxPos,
+			MaspPos:            tx.MaspPos,
+			ExpiryHeight:       tx.ExpiryHeight,
+			SpendsCount:        tx.SpendsCount,
+			ConvertsCount:      tx.ConvertsCount,
+			OutputsCount:       tx.OutputsCount,
+			ValueBalance:       make([]MaspAssetValue, 0, len(tx.ValueBalance)),
+			TransparentInputs:  make([]MaspTransparentValue, 0, len(tx.TransparentInputs)),
+			TransparentOutputs: make([]MaspTransparentValue, 0, len(tx.TransparentOutputs)),
+		}
+		for _, v := range tx.ValueBalance {
+			info.ValueBalance = append(info.ValueBalance, MaspAssetValue{AssetType: v.AssetType, Value: v.Value})
+		}
+		for _, v := range tx.TransparentInputs {
+			info.TransparentInputs = append(info.TransparentInputs, MaspTransparentValue{AssetType: v.AssetType, Value: v.Value, Address: v.Address})
+		}
+		for _, v := range tx.TransparentOutputs {
+			info.TransparentOutputs = append(info.TransparentOutputs, MaspTransparentValue{AssetType: v.AssetType, Value: v.Value, Address: v.Address})
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+func (s *service) GetMaspAsset(ctx context.Context, assetType string) (MaspAssetInfo, error) {
+	assetTypeBytes, err := hexToBytes(assetType)
+	if err != nil {
+		return MaspAssetInfo{}, err
+	}
+
+	assets, err := s.repo.GetMaspAssets(ctx, assetTypeBytes)
+	if err != nil {
+		return MaspAssetInfo{}, err
+	}
+	if len(assets) == 0 {
+		return MaspAssetInfo{}, ErrNotFound
+	}
+
+	return MaspAssetInfo{
+		AssetType: assets[0].AssetType,
+		Token:     assets[0].Token,
+		Denom:     assets[0].Denom,
+		Position:  assets[0].Position,
+		Epoch:     assets[0].Epoch,
+	}, nil
+}
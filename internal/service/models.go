@@ -50,12 +50,157 @@ type TxInfo struct {
 	FeeAmountPerGasUnit *string          `json:"fee_amount_per_gas_unit,omitempty"`
 	FeeToken            *string          `json:"fee_token,omitempty"`
 	GasLimitMultiplier  *uint64          `json:"gas_limit_multiplier,omitempty"`
+	GasUsed             *uint64          `json:"gas_used,omitempty"`
+	FeePaid             *string          `json:"fee_paid,omitempty"`
+	FeePayer            *string          `json:"fee_payer,omitempty"`
 	Code                *Hash            `json:"code,omitempty"`
 	Data                *json.RawMessage `json:"data,omitempty"`
 	ReturnCode          *int64           `json:"return_code,omitempty"`
 	BlockInfo           *BlockShort      `json:"block_info,omitempty"`
 }
 
+type FailedTxInfo struct {
+	BlockID     Hash      `json:"block_id"`
+	BlockHeight int64     `json:"block_height"`
+	TxPos       int64     `json:"tx_pos"`
+	Hash        *Hash     `json:"hash,omitempty"`
+	TxType      string    `json:"tx_type,omitempty"`
+	Data        Hash      `json:"data"`
+	Error       string    `json:"error"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type FeeFilter struct {
+	FeeToken   string
+	FeePayer   string
+	FromHeight int64
+	ToHeight   int64
+	Limit      int64
+	Offset     int64
+}
+
+type FeeStats struct {
+	Height   *int64  `json:"height,omitempty"`
+	Day      *string `json:"day,omitempty"`
+	FeeToken string  `json:"fee_token"`
+	TxsCount uint64  `json:"txs_count"`
+	GasLimit uint64  `json:"gas_limit"`
+	GasUsed  uint64  `json:"gas_used"`
+	FeePaid  string  `json:"fee_paid"`
+}
+
+type EventFilter struct {
+	Type       string
+	Key        string
+	Value      string
+	TxHash     string
+	FromHeight int64
+	ToHeight   int64
+	Limit      int64
+	Offset     int64
+}
+
+type EventAttribute struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type EventInfo struct {
+	BlockID     Hash             `json:"block_id"`
+	BlockHeight int64            `json:"block_height"`
+	Kind        string           `json:"kind"`
+	Type        string           `json:"type"`
+	TxHash      *Hash            `json:"tx_hash,omitempty"`
+	Attributes  []EventAttribute `json:"attributes"`
+}
+
+type IbcTransferFilter struct {
+	Channel string
+	Denom   string
+	Limit   int64
+	Offset  int64
+}
+
+type IbcTransferInfo struct {
+	TxHash             Hash   `json:"tx_hash"`
+	BlockHeight        int64  `json:"block_height"`
+	TxPos              int64  `json:"tx_pos"`
+	MsgType            string `json:"msg_type"`
+	SourcePort         string `json:"source_port"`
+	SourceChannel      string `json:"source_channel"`
+	DestinationPort    string `json:"destination_port,omitempty"`
+	DestinationChannel string `json:"destination_channel,omitempty"`
+	Sequence           int64  `json:"sequence,omitempty"`
+	Denom              string `json:"denom"`
+	Amount             string `json:"amount"`
+	Sender             string `json:"sender"`
+	Receiver           string `json:"receiver"`
+	Memo               string `json:"memo,omitempty"`
+}
+
+type MaspAssetValue struct {
+	AssetType string `json:"asset_type"`
+	Value     string `json:"value"`
+}
+
+type MaspTransparentValue struct {
+	AssetType string `json:"asset_type"`
+	Value     uint64 `json:"value"`
+	Address   string `json:"address"`
+}
+
+type MaspTxInfo struct {
+	TxHash             Hash                   `json:"tx_hash"`
+	BlockHeight        int64                  `json:"block_height"`
+	TxPos              int64                  `json:"tx_pos"`
+	MaspPos            int64                  `json:"masp_pos"`
+	ExpiryHeight       int64                  `json:"expiry_height"`
+	SpendsCount        int64                  `json:"spends_count"`
+	ConvertsCount      int64                  `json:"converts_count"`
+	OutputsCount       int64                  `json:"outputs_count"`
+	ValueBalance       []MaspAssetValue       `json:"value_balance"`
+	TransparentInputs  []MaspTransparentValue `json:"transparent_inputs"`
+	TransparentOutputs []MaspTransparentValue `json:"transparent_outputs"`
+}
+
+type MaspAssetInfo struct {
+	AssetType Hash   `json:"asset_type"`
+	Token     string `json:"token"`
+	Denom     int64  `json:"denom"`
+	Position  int64  `json:"position"`
+	Epoch     *int64 `json:"epoch"`
+}
+
+type Status struct {
+	EarliestHeight int64      `json:"earliest_height"`
+	IndexedHeight  int64      `json:"indexed_height"`
+	ChainHeight    int64      `json:"chain_height"`
+	Lag            int64      `json:"lag"`
+	LastBlockTime  *time.Time `json:"last_block_time,omitempty"`
+	SyncState      string     `json:"sync_state"`
+	IndexerVersion string     `json:"indexer_version"`
+	ServerVersion  string     `json:"server_version"`
+	Checksums      string     `json:"checksums"`
+	ErrorCount     int64      `json:"error_count"`
+	UpdatedAt      *time.Time `json:"updated_at,omitempty"`
+	Replicas       []Replica  `json:"replicas,omitempty"`
+}
+
+type Replica struct {
+	Name       string  `json:"name"`
+	Available  bool    `json:"available"`
+	LagSeconds float64 `json:"lag_seconds"`
+	LagBlocks  int64   `json:"lag_blocks"`
+}
+
+type Health struct {
+	Healthy   bool   `json:"healthy"`
+	Database  bool   `json:"database"`
+	Lag       *int64 `json:"lag,omitempty"`
+	SyncState string `json:"sync_state,omitempty"`
+	Reason    string `json:"reason,omitempty"`
+}
+
 type Uptime struct {
 	Uptime float64 `json:"uptime"`
 }
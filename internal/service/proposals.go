@@ -6,5 +6,10 @@ import (
 )
 
 func (s *service) GetVoteProposalData(ctx context.Context, proposalID int64) ([]json.RawMessage, error) {
-	return s.repo.GetVoteProposalDatas(ctx, s.checksums["tx_vote_proposal"], proposalID)
+	voteCodes, err := s.codeHashes("tx_vote_proposal")
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.GetVoteProposalDatas(ctx, voteCodes, proposalID)
 }
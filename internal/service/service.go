@@ -1,33 +1,45 @@
 package service
 
 import (
+	"github.com/the-laziest/namadexer-go/internal/checksums"
 	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/types"
 	"github.com/the-laziest/namadexer-go/pkg/errors"
 )
 
 type service struct {
 	repo      repository.Repository
-	checksums map[string][]byte
+	checksums *checksums.Registry
 }
 
-func New(repo repository.Repository, checksums map[string]string) (*service, error) {
-	csBytes := make(map[string][]byte, len(checksums))
-	for k, v := range checksums {
-		vbs, err := hexToBytes(v)
+func New(repo repository.Repository, checksums *checksums.Registry) (*service, error) {
+	s := &service{repo, checksums}
+
+	for _, txType := range []string{"tx_vote_proposal", "tx_update_account"} {
+		hashes, err := s.codeHashes(txType)
 		if err != nil {
 			return nil, errors.New(err, "Reformat checksums")
 		}
-		csBytes[k] = vbs
+		if len(hashes) == 0 {
+			return nil, errors.Create(txType + " hash in checksums not found")
+		}
 	}
 
-	if _, ok := csBytes["tx_vote_proposal"]; !ok {
-		return nil, errors.Create("tx_vote_proposal hash in checksums not found")
-	}
-	if _, ok := csBytes["tx_update_account"]; !ok {
-		return nil, errors.Create("tx_update_account hash in checksums not found")
-	}
+	return s, nil
+}
 
-	return &service{repo, csBytes}, nil
+// codeHashes returns code hashes of the tx type of all known Namada versions
+func (s *service) codeHashes(txType string) ([][]byte, error) {
+	rawHashes := s.checksums.Hashes(txType)
+	hashes := make([][]byte, 0, len(rawHashes))
+	for _, rawHash := range rawHashes {
+		hash, err := hexToBytes(rawHash)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
 }
 
-const MASP_ADDR = "tnam1pcqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzmefah"
+const MASP_ADDR = types.MASP_ADDR
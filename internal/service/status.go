@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/the-laziest/namadexer-go/internal/repository"
+	"github.com/the-laziest/namadexer-go/internal/version"
+	"github.com/the-laziest/namadexer-go/pkg/logger"
+)
+
+func (s *service) GetStatus(ctx context.Context) (Status, error) {
+	status := Status{
+		SyncState:     repository.SyncStateStopped,
+		ServerVersion: version.String(),
+		Checksums:     s.checksums.Fingerprint(),
+	}
+
+	earliestHeight, err := s.repo.GetEarliestHeight(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+	status.EarliestHeight = earliestHeight
+
+	replicas, err := s.repo.GetReplicasStatus(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+
+	rStatus, err := s.repo.GetIndexerStatus(ctx)
+	if err == repository.ErrNotFound {
+		status.IndexedHeight, err = s.repo.GetLastHeight(ctx)
+		status.Replicas = replicasLag(replicas, status.IndexedHeight)
+		return status, err
+	}
+	if err != nil {
+		return Status{}, err
+	}
+
+	status.IndexedHeight = rStatus.IndexedHeight
+	status.ChainHeight = rStatus.ChainHeight
+	status.Lag = max(rStatus.ChainHeight-rStatus.IndexedHeight, 0)
+	status.LastBlockTime = rStatus.LastBlockTime
+	status.SyncState = rStatus.SyncState
+	status.IndexerVersion = rStatus.Version
+	status.Checksums = rStatus.Checksums
+	status.ErrorCount = rStatus.ErrorCount
+	status.UpdatedAt = &rStatus.UpdatedAt
+	status.Replicas = replicasLag(replicas, status.IndexedHeight)
+
+	return status, nil
+}
+
+// replicasLag reports lag of replicas in time and in blocks behind the indexed height of the primary.
+func replicasLag(replicas []repository.ReplicaStatus, indexedHeight int64) []Replica {
+	var result []Replica
+	for _, replica := range replicas {
+		result = append(result, Replica{
+			Name:       replica.Name,
+			Available:  replica.Available,
+			LagSeconds: replica.Lag.Seconds(),
+			LagBlocks:  max(indexedHeight-replica.LastHeight, 0),
+		})
+	}
+	return result
+}
+
+// GetHealth reports whether the database is reachable and the indexer lag doesn't exceed maxLag.
+// If staleIntervals isn't 0, the indexer must be running and must have saved its status within the last
+// staleIntervals status intervals. The lag isn't checked if maxLag is 0.
+func (s *service) GetHealth(ctx context.Context, maxLag, staleIntervals int64) (Health, error) {
+	if err := s.repo.Ping(ctx); err != nil {
+		logger.Error("Database health check failed", zap.Error(err))
+		return Health{Reason: "database is unreachable"}, nil
+	}
+
+	health := Health{Healthy: true, Database: true}
+
+	status, err := s.repo.GetIndexerStatus(ctx)
+	if err != nil && err != repository.ErrNotFound {
+		return Health{}, err
+	}
+	if err == nil {
+		lag := max(status.ChainHeight-status.IndexedHeight, 0)
+		health.Lag = &lag
+		health.SyncState = status.SyncState
+	}
+
+	if maxLag > 0 || staleIntervals > 0 {
+		switch {
+		case health.Lag == nil:
+			health.Healthy = false
+			health.Reason = "indexer status is unknown"
+		case staleIntervals > 0 && (status.SyncState == repository.SyncStateFailed || status.SyncState == repository.SyncStateStopped):
+			health.Healthy = false
+			health.Reason = "indexer is " + status.SyncState
+		case staleIntervals > 0 && time.Since(status.UpdatedAt) > time.Duration(staleIntervals)*repository.IndexerStatusInterval:
+			health.Healthy = false
+			health.Reason = "indexer status is stale"
+		case maxLag > 0 && *health.Lag > maxLag:
+			health.Healthy = false
+			health.Reason = "indexer lags behind the chain"
+		}
+	}
+
+	return health, nil
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"encoding/json"
+	"slices"
 	"strconv"
 
 	"github.com/the-laziest/namadexer-go/internal/repository"
@@ -34,6 +35,15 @@ func repoTxToInfo(tx repository.Transaction) TxInfo {
 	if tx.GasLimitMultiplier != nil {
 		info.GasLimitMultiplier = tx.GasLimitMultiplier
 	}
+	if tx.GasUsed != nil {
+		info.GasUsed = tx.GasUsed
+	}
+	if tx.FeePaid != nil {
+		info.FeePaid = tx.FeePaid
+	}
+	if len(tx.FeePayer) != 0 {
+		info.FeePayer = &tx.FeePayer
+	}
 	if len(tx.Code) != 0 {
 		code := Hash(tx.Code)
 		info.Code = &code
@@ -56,7 +66,7 @@ func repoTxsToShort(txs []repository.Transaction) []TxShort {
 	return txsShort
 }
 
-func (s *service) GetTxsByHashes(ctx context.Context, hashes ...string) ([]TxInfo, error) {
+func (s *service) GetTxsByHashes(ctx context.Context, height int64, hashes ...string) ([]TxInfo, error) {
 	if len(hashes) == 0 {
 		return nil, ErrNotFound
 	}
@@ -70,7 +80,7 @@ func (s *service) GetTxsByHashes(ctx context.Context, hashes ...string) ([]TxInf
 		hashIDs = append(hashIDs, hashID)
 	}
 
-	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{Hashes: hashIDs})
+	txs, err := s.repo.GetTxsBy(ctx, repository.TxFilter{Hashes: hashIDs, Height: max(height, 0)})
 	if err != nil {
 		return nil, err
 	}
@@ -102,15 +112,31 @@ func (s *service) GetTxsByMemo(ctx context.Context, memo string, rLimit, rOffset
 	return repoTxsToShort(txs), nil
 }
 
-func (s *service) GetTotalTxsByAccount(ctx context.Context, address string) (Total, error) {
-	total, err := s.repo.GetTotalAccountTxs(ctx, []byte(address))
+// checkAccountRole accepts an empty role, which matches txs with any role of the account.
+func checkAccountRole(role string) error {
+	if role != "" && !slices.Contains(repository.AccountRoles, role) {
+		return ErrBadRequest
+	}
+	return nil
+}
+
+func (s *service) GetTotalTxsByAccount(ctx context.Context, address, role string) (Total, error) {
+	if err := checkAccountRole(role); err != nil {
+		return Total{}, err
+	}
+
+	total, err := s.repo.GetTotalAccountTxs(ctx, []byte(address), role)
 	return Total{total}, err
 }
 
-func (s *service) GetTxsByAccount(ctx context.Context, address string, rLimit, rOffset int64) ([]Hash, error) {
+func (s *service) GetTxsByAccount(ctx context.Context, address, role string, rLimit, rOffset int64) ([]Hash, error) {
+	if err := checkAccountRole(role); err != nil {
+		return nil, err
+	}
+
 	limit, offset := prepareLimitAndOffset(rLimit, rOffset)
 
-	rawHashes, err := s.repo.GetAccountTxs(ctx, []byte(address), limit, offset)
+	rawHashes, err := s.repo.GetAccountTxs(ctx, []byte(address), role, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -123,6 +149,35 @@ func (s *service) GetTxsByAccount(ctx context.Context, address string, rLimit, r
 	return hashes, nil
 }
 
+func (s *service) GetFailedTxs(ctx context.Context, rLimit, rOffset int64) ([]FailedTxInfo, error) {
+	limit, offset := prepareLimitAndOffset(rLimit, rOffset)
+
+	txs, err := s.repo.GetFailedTxs(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]FailedTxInfo, 0, len(txs))
+	for _, tx := range txs {
+		info := FailedTxInfo{
+			BlockID:     tx.BlockID,
+			BlockHeight: tx.BlockHeight,
+			TxPos:       tx.TxPos,
+			TxType:      tx.TxType,
+			Data:        tx.Data,
+			Error:       tx.Error,
+			CreatedAt:   tx.CreatedAt,
+		}
+		if len(tx.TxHash) > 0 {
+			hash := Hash(tx.TxHash)
+			info.Hash = &hash
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
 func prepareLimitAndOffset(limit, offset int64) (uint64, uint64) {
 	if limit <= 0 {
 		limit = 20
@@ -141,6 +196,18 @@ func (s *service) GetShielded(ctx context.Context) (ShieldedAssets, error) {
 
 	shielded := make(map[string]float64)
 
+	pruned, err := s.repo.GetPrunedShielded(ctx)
+	if err != nil {
+		return ShieldedAssets{}, err
+	}
+	for _, p := range pruned {
+		amount, err := strconv.ParseFloat(p.Amount, 64)
+		if err != nil {
+			return ShieldedAssets{}, err
+		}
+		shielded[p.Token] = amount
+	}
+
 	for _, tx := range txs {
 
 		var transfer Transfer
@@ -0,0 +1,268 @@
+package ibc
+
+import (
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pb "github.com/the-laziest/namadexer-go/internal/types/ibc/proto"
+)
+
+// Decoded protobuf messages are converted to the types above, so they are marshaled to JSON
+// with hex encoded bytes and names of enums.
+
+func newAny(a *anypb.Any) Any {
+	return Any{TypeURL: a.GetTypeUrl(), Value: a.GetValue()}
+}
+
+func newHeight(h *pb.Height) Height {
+	return Height{RevisionNumber: h.GetRevisionNumber(), RevisionHeight: h.GetRevisionHeight()}
+}
+
+func newCoin(c *pb.Coin) Coin {
+	return Coin{Denom: c.GetDenom(), Amount: c.GetAmount()}
+}
+
+func newPacket(p *pb.Packet) Packet {
+	return Packet{
+		Sequence:           p.GetSequence(),
+		SourcePort:         p.GetSourcePort(),
+		SourceChannel:      p.GetSourceChannel(),
+		DestinationPort:    p.GetDestinationPort(),
+		DestinationChannel: p.GetDestinationChannel(),
+		Data:               p.GetData(),
+		TimeoutHeight:      newHeight(p.GetTimeoutHeight()),
+		TimeoutTimestamp:   p.GetTimeoutTimestamp(),
+	}
+}
+
+func newMsgTransfer(m *pb.MsgTransfer) *MsgTransfer {
+	return &MsgTransfer{
+		SourcePort:       m.GetSourcePort(),
+		SourceChannel:    m.GetSourceChannel(),
+		Token:            newCoin(m.GetToken()),
+		Sender:           m.GetSender(),
+		Receiver:         m.GetReceiver(),
+		TimeoutHeight:    newHeight(m.GetTimeoutHeight()),
+		TimeoutTimestamp: m.GetTimeoutTimestamp(),
+		Memo:             m.GetMemo(),
+	}
+}
+
+func newMsgRecvPacket(m *pb.MsgRecvPacket) *MsgRecvPacket {
+	return &MsgRecvPacket{
+		Packet:          newPacket(m.GetPacket()),
+		ProofCommitment: m.GetProofCommitment(),
+		ProofHeight:     newHeight(m.GetProofHeight()),
+		Signer:          m.GetSigner(),
+	}
+}
+
+func newMsgAcknowledgement(m *pb.MsgAcknowledgement) *MsgAcknowledgement {
+	return &MsgAcknowledgement{
+		Packet:          newPacket(m.GetPacket()),
+		Acknowledgement: m.GetAcknowledgement(),
+		ProofAcked:      m.GetProofAcked(),
+		ProofHeight:     newHeight(m.GetProofHeight()),
+		Signer:          m.GetSigner(),
+	}
+}
+
+func newMsgTimeout(m *pb.MsgTimeout) *MsgTimeout {
+	return &MsgTimeout{
+		Packet:           newPacket(m.GetPacket()),
+		ProofUnreceived:  m.GetProofUnreceived(),
+		ProofHeight:      newHeight(m.GetProofHeight()),
+		NextSequenceRecv: m.GetNextSequenceRecv(),
+		Signer:           m.GetSigner(),
+	}
+}
+
+func newMsgTimeoutOnClose(m *pb.MsgTimeoutOnClose) *MsgTimeoutOnClose {
+	return &MsgTimeoutOnClose{
+		Packet:           newPacket(m.GetPacket()),
+		ProofUnreceived:  m.GetProofUnreceived(),
+		ProofClose:       m.GetProofClose(),
+		ProofHeight:      newHeight(m.GetProofHeight()),
+		NextSequenceRecv: m.GetNextSequenceRecv(),
+		Signer:           m.GetSigner(),
+	}
+}
+
+func newMsgCreateClient(m *pb.MsgCreateClient) *MsgCreateClient {
+	return &MsgCreateClient{
+		ClientState:    newAny(m.GetClientState()),
+		ConsensusState: newAny(m.GetConsensusState()),
+		Signer:         m.GetSigner(),
+	}
+}
+
+func newMsgUpdateClient(m *pb.MsgUpdateClient) *MsgUpdateClient {
+	return &MsgUpdateClient{
+		ClientID:      m.GetClientId(),
+		ClientMessage: newAny(m.GetClientMessage()),
+		Signer:        m.GetSigner(),
+	}
+}
+
+func newMsgUpgradeClient(m *pb.MsgUpgradeClient) *MsgUpgradeClient {
+	return &MsgUpgradeClient{
+		ClientID:                   m.GetClientId(),
+		ClientState:                newAny(m.GetClientState()),
+		ConsensusState:             newAny(m.GetConsensusState()),
+		ProofUpgradeClient:         m.GetProofUpgradeClient(),
+		ProofUpgradeConsensusState: m.GetProofUpgradeConsensusState(),
+		Signer:                     m.GetSigner(),
+	}
+}
+
+func newMsgSubmitMisbehaviour(m *pb.MsgSubmitMisbehaviour) *MsgSubmitMisbehaviour {
+	return &MsgSubmitMisbehaviour{
+		ClientID:     m.GetClientId(),
+		Misbehaviour: newAny(m.GetMisbehaviour()),
+		Signer:       m.GetSigner(),
+	}
+}
+
+func newConnectionCounterparty(c *pb.ConnectionCounterparty) ConnectionCounterparty {
+	return ConnectionCounterparty{
+		ClientID:     c.GetClientId(),
+		ConnectionID: c.GetConnectionId(),
+		Prefix:       MerklePrefix{KeyPrefix: c.GetPrefix().GetKeyPrefix()},
+	}
+}
+
+func newVersion(v *pb.Version) *Version {
+	if v == nil {
+		return nil
+	}
+	return &Version{Identifier: v.GetIdentifier(), Features: v.GetFeatures()}
+}
+
+func newMsgConnectionOpenInit(m *pb.MsgConnectionOpenInit) *MsgConnectionOpenInit {
+	return &MsgConnectionOpenInit{
+		ClientID:     m.GetClientId(),
+		Counterparty: newConnectionCounterparty(m.GetCounterparty()),
+		Version:      newVersion(m.GetVersion()),
+		DelayPeriod:  m.GetDelayPeriod(),
+		Signer:       m.GetSigner(),
+	}
+}
+
+func newMsgConnectionOpenTry(m *pb.MsgConnectionOpenTry) *MsgConnectionOpenTry {
+	var versions []Version
+	for _, v := range m.GetCounterpartyVersions() {
+		versions = append(versions, *newVersion(v))
+	}
+	return &MsgConnectionOpenTry{
+		ClientID:                m.GetClientId(),
+		PreviousConnectionID:    m.GetPreviousConnectionId(),
+		ClientState:             newAny(m.GetClientState()),
+		Counterparty:            newConnectionCounterparty(m.GetCounterparty()),
+		DelayPeriod:             m.GetDelayPeriod(),
+		CounterpartyVersions:    versions,
+		ProofHeight:             newHeight(m.GetProofHeight()),
+		ProofInit:               m.GetProofInit(),
+		ProofClient:             m.GetProofClient(),
+		ProofConsensus:          m.GetProofConsensus(),
+		ConsensusHeight:         newHeight(m.GetConsensusHeight()),
+		Signer:                  m.GetSigner(),
+		HostConsensusStateProof: m.GetHostConsensusStateProof(),
+	}
+}
+
+func newMsgConnectionOpenAck(m *pb.MsgConnectionOpenAck) *MsgConnectionOpenAck {
+	return &MsgConnectionOpenAck{
+		ConnectionID:             m.GetConnectionId(),
+		CounterpartyConnectionID: m.GetCounterpartyConnectionId(),
+		Version:                  newVersion(m.GetVersion()),
+		ClientState:              newAny(m.GetClientState()),
+		ProofHeight:              newHeight(m.GetProofHeight()),
+		ProofTry:                 m.GetProofTry(),
+		ProofClient:              m.GetProofClient(),
+		ProofConsensus:           m.GetProofConsensus(),
+		ConsensusHeight:          newHeight(m.GetConsensusHeight()),
+		Signer:                   m.GetSigner(),
+		HostConsensusStateProof:  m.GetHostConsensusStateProof(),
+	}
+}
+
+func newMsgConnectionOpenConfirm(m *pb.MsgConnectionOpenConfirm) *MsgConnectionOpenConfirm {
+	return &MsgConnectionOpenConfirm{
+		ConnectionID: m.GetConnectionId(),
+		ProofAck:     m.GetProofAck(),
+		ProofHeight:  newHeight(m.GetProofHeight()),
+		Signer:       m.GetSigner(),
+	}
+}
+
+func newChannel(c *pb.Channel) Channel {
+	return Channel{
+		State:    ChannelState(c.GetState()),
+		Ordering: ChannelOrder(c.GetOrdering()),
+		Counterparty: ChannelCounterparty{
+			PortID:    c.GetCounterparty().GetPortId(),
+			ChannelID: c.GetCounterparty().GetChannelId(),
+		},
+		ConnectionHops: c.GetConnectionHops(),
+		Version:        c.GetVersion(),
+	}
+}
+
+func newMsgChannelOpenInit(m *pb.MsgChannelOpenInit) *MsgChannelOpenInit {
+	return &MsgChannelOpenInit{
+		PortID:  m.GetPortId(),
+		Channel: newChannel(m.GetChannel()),
+		Signer:  m.GetSigner(),
+	}
+}
+
+func newMsgChannelOpenTry(m *pb.MsgChannelOpenTry) *MsgChannelOpenTry {
+	return &MsgChannelOpenTry{
+		PortID:              m.GetPortId(),
+		PreviousChannelID:   m.GetPreviousChannelId(),
+		Channel:             newChannel(m.GetChannel()),
+		CounterpartyVersion: m.GetCounterpartyVersion(),
+		ProofInit:           m.GetProofInit(),
+		ProofHeight:         newHeight(m.GetProofHeight()),
+		Signer:              m.GetSigner(),
+	}
+}
+
+func newMsgChannelOpenAck(m *pb.MsgChannelOpenAck) *MsgChannelOpenAck {
+	return &MsgChannelOpenAck{
+		PortID:                m.GetPortId(),
+		ChannelID:             m.GetChannelId(),
+		CounterpartyChannelID: m.GetCounterpartyChannelId(),
+		CounterpartyVersion:   m.GetCounterpartyVersion(),
+		ProofTry:              m.GetProofTry(),
+		ProofHeight:           newHeight(m.GetProofHeight()),
+		Signer:                m.GetSigner(),
+	}
+}
+
+func newMsgChannelOpenConfirm(m *pb.MsgChannelOpenConfirm) *MsgChannelOpenConfirm {
+	return &MsgChannelOpenConfirm{
+		PortID:      m.GetPortId(),
+		ChannelID:   m.GetChannelId(),
+		ProofAck:    m.GetProofAck(),
+		ProofHeight: newHeight(m.GetProofHeight()),
+		Signer:      m.GetSigner(),
+	}
+}
+
+func newMsgChannelCloseInit(m *pb.MsgChannelCloseInit) *MsgChannelCloseInit {
+	return &MsgChannelCloseInit{
+		PortID:    m.GetPortId(),
+		ChannelID: m.GetChannelId(),
+		Signer:    m.GetSigner(),
+	}
+}
+
+func newMsgChannelCloseConfirm(m *pb.MsgChannelCloseConfirm) *MsgChannelCloseConfirm {
+	return &MsgChannelCloseConfirm{
+		PortID:      m.GetPortId(),
+		ChannelID:   m.GetChannelId(),
+		ProofInit:   m.GetProofInit(),
+		ProofHeight: newHeight(m.GetProofHeight()),
+		Signer:      m.GetSigner(),
+	}
+}
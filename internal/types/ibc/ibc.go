@@ -0,0 +1,154 @@
+// Package ibc decodes IBC messages of tx_ibc transactions.
+package ibc
+
+import (
+	"encoding/binary"
+	"strings"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	pb "github.com/the-laziest/namadexer-go/internal/types/ibc/proto"
+	"github.com/the-laziest/namadexer-go/pkg/errors"
+)
+
+// MsgShieldedTransferType is the type of Namada transfers to shielded addresses, they are not packed into Any.
+const MsgShieldedTransferType = "MsgShieldedTransfer"
+
+var messageTypes = map[string]func(value []byte) (any, error){
+	"/ibc.applications.transfer.v1.MsgTransfer":        decoder(newMsgTransfer),
+	"/ibc.core.channel.v1.MsgRecvPacket":               decoder(newMsgRecvPacket),
+	"/ibc.core.channel.v1.MsgAcknowledgement":          decoder(newMsgAcknowledgement),
+	"/ibc.core.channel.v1.MsgTimeout":                  decoder(newMsgTimeout),
+	"/ibc.core.channel.v1.MsgTimeoutOnClose":           decoder(newMsgTimeoutOnClose),
+	"/ibc.core.client.v1.MsgCreateClient":              decoder(newMsgCreateClient),
+	"/ibc.core.client.v1.MsgUpdateClient":              decoder(newMsgUpdateClient),
+	"/ibc.core.client.v1.MsgUpgradeClient":             decoder(newMsgUpgradeClient),
+	"/ibc.core.client.v1.MsgSubmitMisbehaviour":        decoder(newMsgSubmitMisbehaviour),
+	"/ibc.core.connection.v1.MsgConnectionOpenInit":    decoder(newMsgConnectionOpenInit),
+	"/ibc.core.connection.v1.MsgConnectionOpenTry":     decoder(newMsgConnectionOpenTry),
+	"/ibc.core.connection.v1.MsgConnectionOpenAck":     decoder(newMsgConnectionOpenAck),
+	"/ibc.core.connection.v1.MsgConnectionOpenConfirm": decoder(newMsgConnectionOpenConfirm),
+	"/ibc.core.channel.v1.MsgChannelOpenInit":          decoder(newMsgChannelOpenInit),
+	"/ibc.core.channel.v1.MsgChannelOpenTry":           decoder(newMsgChannelOpenTry),
+	"/ibc.core.channel.v1.MsgChannelOpenAck":           decoder(newMsgChannelOpenAck),
+	"/ibc.core.channel.v1.MsgChannelOpenConfirm":       decoder(newMsgChannelOpenConfirm),
+	"/ibc.core.channel.v1.MsgChannelCloseInit":         decoder(newMsgChannelCloseInit),
+	"/ibc.core.channel.v1.MsgChannelCloseConfirm":      decoder(newMsgChannelCloseConfirm),
+}
+
+// decoder returns the function decoding the generated protobuf message M and converting it by convert.
+func decoder[M any, PM interface {
+	*M
+	proto.Message
+}, T any](convert func(PM) T) func(value []byte) (any, error) {
+	return func(value []byte) (any, error) {
+		msg := PM(new(M))
+		if err := proto.Unmarshal(value, msg); err != nil {
+			return nil, err
+		}
+		return convert(msg), nil
+	}
+}
+
+// Message is a decoded message of tx_ibc data.
+type Message struct {
+	Type    string `json:"type"`
+	Message any    `json:"message,omitempty"`
+	// Value keeps messages of unknown types encoded
+	Value bytes.HexBytes `json:"value,omitempty"`
+	// ShieldedTransfer is the MASP part of shielded transfers, it's kept encoded
+	ShieldedTransfer bytes.HexBytes `json:"shielded_transfer,omitempty"`
+}
+
+// Decode decodes tx_ibc data the same way as Namada does: as a message packed into Any
+// or as a borsh encoded shielded transfer if it's not Any.
+func Decode(data []byte) (Message, error) {
+	var packed anypb.Any
+	if err := proto.Unmarshal(data, &packed); err == nil && strings.HasPrefix(packed.TypeUrl, "/") {
+		decode, ok := messageTypes[packed.TypeUrl]
+		if !ok {
+			return Message{Type: packed.TypeUrl, Value: packed.Value}, nil
+		}
+		msg, err := decode(packed.Value)
+		if err != nil {
+			return Message{}, errors.New(err, "Decode "+packed.TypeUrl)
+		}
+		return Message{Type: packed.TypeUrl, Message: msg}, nil
+	}
+
+	// Shielded transfer is a pair of encoded MsgTransfer and the MASP transfer
+	if len(data) < 4 {
+		return Message{}, errors.Create("Invalid IBC message")
+	}
+	size := binary.LittleEndian.Uint32(data)
+	if uint64(size) > uint64(len(data)-4) {
+		return Message{}, errors.Create("Invalid IBC message")
+	}
+	var msg pb.MsgTransfer
+	if err := proto.Unmarshal(data[4:4+size], &msg); err != nil {
+		return Message{}, errors.New(err, "Decode "+MsgShieldedTransferType)
+	}
+
+	return Message{Type: MsgShieldedTransferType, Message: newMsgTransfer(&msg), ShieldedTransfer: data[4+size:]}, nil
+}
+
+// Transfer is a token transfer made by an IBC message. Destination and sequence of sent transfers
+// are not known until the packet is committed, they are empty then.
+type Transfer struct {
+	SourcePort         string
+	SourceChannel      string
+	DestinationPort    string
+	DestinationChannel string
+	Sequence           uint64
+	Denom              string
+	Amount             string
+	Sender             string
+	Receiver           string
+	Memo               string
+}
+
+// Transfer returns the token transfer of transfer messages and messages with packets of the transfer module.
+func (m Message) Transfer() (Transfer, bool) {
+	var packet Packet
+	switch msg := m.Message.(type) {
+	case *MsgTransfer:
+		return Transfer{
+			SourcePort:    msg.SourcePort,
+			SourceChannel: msg.SourceChannel,
+			Denom:         msg.Token.Denom,
+			Amount:        msg.Token.Amount,
+			Sender:        msg.Sender,
+			Receiver:      msg.Receiver,
+			Memo:          msg.Memo,
+		}, true
+	case *MsgRecvPacket:
+		packet = msg.Packet
+	case *MsgAcknowledgement:
+		packet = msg.Packet
+	case *MsgTimeout:
+		packet = msg.Packet
+	case *MsgTimeoutOnClose:
+		packet = msg.Packet
+	default:
+		return Transfer{}, false
+	}
+
+	data, ok := packet.TokenData()
+	if !ok {
+		return Transfer{}, false
+	}
+	return Transfer{
+		SourcePort:         packet.SourcePort,
+		SourceChannel:      packet.SourceChannel,
+		DestinationPort:    packet.DestinationPort,
+		DestinationChannel: packet.DestinationChannel,
+		Sequence:           packet.Sequence,
+		Denom:              data.Denom,
+		Amount:             data.Amount,
+		Sender:             data.Sender,
+		Receiver:           data.Receiver,
+		Memo:               data.Memo,
+	}, true
+}
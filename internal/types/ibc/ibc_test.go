@@ -0,0 +1,176 @@
+package ibc
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+type (
+	fixed32 uint32
+	fixed64 uint64
+)
+
+// message encodes fields given as number and value pairs, values are strings, bytes or nested messages,
+// uint64s, fixed32s, fixed64s and packed []uint64s.
+func message(fields ...any) []byte {
+	var b []byte
+	for i := 0; i < len(fields); i += 2 {
+		num := protowire.Number(fields[i].(int))
+		switch value := fields[i+1].(type) {
+		case string:
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendString(b, value)
+		case []byte:
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendBytes(b, value)
+		case uint64:
+			b = protowire.AppendTag(b, num, protowire.VarintType)
+			b = protowire.AppendVarint(b, value)
+		case fixed32:
+			b = protowire.AppendTag(b, num, protowire.Fixed32Type)
+			b = protowire.AppendFixed32(b, uint32(value))
+		case fixed64:
+			b = protowire.AppendTag(b, num, protowire.Fixed64Type)
+			b = protowire.AppendFixed64(b, uint64(value))
+		case []uint64:
+			var packed []byte
+			for _, v := range value {
+				packed = protowire.AppendVarint(packed, v)
+			}
+			b = protowire.AppendTag(b, num, protowire.BytesType)
+			b = protowire.AppendBytes(b, packed)
+		}
+	}
+	return b
+}
+
+func msgTransfer() []byte {
+	return message(
+		1, "transfer",
+		2, "channel-0",
+		3, message(1, "nam", 2, "100"),
+		4, "tnam1sender",
+		5, "cosmos1receiver",
+		6, message(1, uint64(1), 2, uint64(500)),
+		7, uint64(1700000000),
+		// Unknown fields of all wire types are skipped
+		15, uint64(1),
+		16, fixed32(2),
+		17, fixed64(3),
+		18, []uint64{4, 5},
+	)
+}
+
+func TestDecodeTransfer(t *testing.T) {
+	msg, err := Decode(message(1, "/ibc.applications.transfer.v1.MsgTransfer", 2, msgTransfer()))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	transfer, ok := msg.Message.(*MsgTransfer)
+	if !ok {
+		t.Fatalf("message = %T, want *MsgTransfer", msg.Message)
+	}
+	if transfer.SourceChannel != "channel-0" || transfer.Token.Amount != "100" || transfer.TimeoutHeight.RevisionHeight != 500 ||
+		transfer.TimeoutTimestamp != 1700000000 {
+		t.Errorf("MsgTransfer = %+v", transfer)
+	}
+
+	got, ok := msg.Transfer()
+	want := Transfer{SourcePort: "transfer", SourceChannel: "channel-0", Denom: "nam", Amount: "100", Sender: "tnam1sender", Receiver: "cosmos1receiver"}
+	if !ok || got != want {
+		t.Errorf("Transfer = %+v, %v, want %+v", got, ok, want)
+	}
+}
+
+func TestDecodeRecvPacket(t *testing.T) {
+	packet := message(
+		1, uint64(7),
+		2, "transfer",
+		3, "channel-5",
+		4, "transfer",
+		5, "channel-0",
+		6, `{"denom":"uatom","amount":"5","sender":"cosmos1sender","receiver":"tnam1receiver"}`,
+	)
+	msg, err := Decode(message(1, "/ibc.core.channel.v1.MsgRecvPacket", 2, message(1, packet, 2, []byte{0xab}, 4, "relayer")))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+
+	got, ok := msg.Transfer()
+	want := Transfer{SourcePort: "transfer", SourceChannel: "channel-5", DestinationPort: "transfer", DestinationChannel: "channel-0",
+		Sequence: 7, Denom: "uatom", Amount: "5", Sender: "cosmos1sender", Receiver: "tnam1receiver"}
+	if !ok || got != want {
+		t.Errorf("Transfer = %+v, %v, want %+v", got, ok, want)
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var decoded struct {
+		Message struct {
+			Packet struct {
+				Data struct {
+					Denom string `json:"denom"`
+				} `json:"data"`
+			} `json:"packet"`
+			ProofCommitment string `json:"proof_commitment"`
+		} `json:"message"`
+	}
+	if err = json.Unmarshal(data, &decoded); err != nil || decoded.Message.Packet.Data.Denom != "uatom" || decoded.Message.ProofCommitment != "AB" {
+		t.Errorf("JSON = %s, %v", data, err)
+	}
+}
+
+func TestDecodeChannelOpenInit(t *testing.T) {
+	channel := message(1, uint64(1), 2, uint64(1), 3, message(1, "transfer"), 4, "connection-0", 4, "connection-1", 5, "ics20-1")
+	msg, err := Decode(message(1, "/ibc.core.channel.v1.MsgChannelOpenInit", 2, message(1, "transfer", 2, channel, 3, "signer")))
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if _, ok := msg.Transfer(); ok {
+		t.Errorf("channel message has transfer")
+	}
+
+	data, err := json.Marshal(msg.Message)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	want := `{"port_id":"transfer","channel":{"state":"INIT","ordering":"UNORDERED","counterparty":{"port_id":"transfer"},` +
+		`"connection_hops":["connection-0","connection-1"],"version":"ics20-1"},"signer":"signer"}`
+	if string(data) != want {
+		t.Errorf("JSON = %s, want %s", data, want)
+	}
+}
+
+func TestDecodeUnknown(t *testing.T) {
+	msg, err := Decode(message(1, "/ibc.unknown.Msg", 2, []byte{1, 2}))
+	if err != nil || msg.Type != "/ibc.unknown.Msg" || msg.Message != nil || msg.Value.String() != "0102" {
+		t.Errorf("Decode = %+v, %v", msg, err)
+	}
+}
+
+func TestDecodeShieldedTransfer(t *testing.T) {
+	encoded := msgTransfer()
+	data := binary.LittleEndian.AppendUint32(nil, uint32(len(encoded)))
+	data = append(data, encoded...)
+	data = append(data, 0xca, 0xfe)
+
+	msg, err := Decode(data)
+	if err != nil {
+		t.Fatalf("Decode: %v", err)
+	}
+	if msg.Type != MsgShieldedTransferType || msg.ShieldedTransfer.String() != "CAFE" {
+		t.Errorf("Decode = %+v", msg)
+	}
+	if transfer, ok := msg.Transfer(); !ok || transfer.Sender != "tnam1sender" {
+		t.Errorf("Transfer = %+v, %v", transfer, ok)
+	}
+
+	if _, err = Decode([]byte{0xff, 0xff, 0xff, 0x7f, 1}); err == nil {
+		t.Errorf("Decode of invalid data succeeded")
+	}
+}
@@ -0,0 +1,275 @@
+package ibc
+
+import (
+	"encoding/json"
+
+	"github.com/tendermint/tendermint/libs/bytes"
+)
+
+// Any is a protobuf message packed with its type URL, nested messages of light clients are kept encoded.
+type Any struct {
+	TypeURL string         `json:"type_url"`
+	Value   bytes.HexBytes `json:"value"`
+}
+
+type Height struct {
+	RevisionNumber uint64 `json:"revision_number"`
+	RevisionHeight uint64 `json:"revision_height"`
+}
+
+type Coin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// PacketData is marshaled as is if it's JSON like data of fungible token packets and as hex otherwise.
+type PacketData []byte
+
+func (pd PacketData) MarshalJSON() ([]byte, error) {
+	if json.Valid(pd) {
+		return pd, nil
+	}
+	return json.Marshal(bytes.HexBytes(pd))
+}
+
+// FungibleTokenPacketData is data of packets sent by the transfer module, the amount is a decimal string.
+type FungibleTokenPacketData struct {
+	Denom    string `json:"denom"`
+	Amount   string `json:"amount"`
+	Sender   string `json:"sender"`
+	Receiver string `json:"receiver"`
+	Memo     string `json:"memo,omitempty"`
+}
+
+type Packet struct {
+	Sequence           uint64     `json:"sequence"`
+	SourcePort         string     `json:"source_port"`
+	SourceChannel      string     `json:"source_channel"`
+	DestinationPort    string     `json:"destination_port"`
+	DestinationChannel string     `json:"destination_channel"`
+	Data               PacketData `json:"data"`
+	TimeoutHeight      Height     `json:"timeout_height"`
+	TimeoutTimestamp   uint64     `json:"timeout_timestamp"`
+}
+
+// TokenData returns data of the packet if it's sent by the transfer module.
+func (p Packet) TokenData() (FungibleTokenPacketData, bool) {
+	var data FungibleTokenPacketData
+	if err := json.Unmarshal(p.Data, &data); err != nil || data.Denom == "" {
+		return FungibleTokenPacketData{}, false
+	}
+	return data, true
+}
+
+type MsgTransfer struct {
+	SourcePort       string `json:"source_port"`
+	SourceChannel    string `json:"source_channel"`
+	Token            Coin   `json:"token"`
+	Sender           string `json:"sender"`
+	Receiver         string `json:"receiver"`
+	TimeoutHeight    Height `json:"timeout_height"`
+	TimeoutTimestamp uint64 `json:"timeout_timestamp"`
+	Memo             string `json:"memo,omitempty"`
+}
+
+type MsgRecvPacket struct {
+	Packet          Packet         `json:"packet"`
+	ProofCommitment bytes.HexBytes `json:"proof_commitment"`
+	ProofHeight     Height         `json:"proof_height"`
+	Signer          string         `json:"signer"`
+}
+
+type MsgAcknowledgement struct {
+	Packet          Packet         `json:"packet"`
+	Acknowledgement PacketData     `json:"acknowledgement"`
+	ProofAcked      bytes.HexBytes `json:"proof_acked"`
+	ProofHeight     Height         `json:"proof_height"`
+	Signer          string         `json:"signer"`
+}
+
+type MsgTimeout struct {
+	Packet           Packet         `json:"packet"`
+	ProofUnreceived  bytes.HexBytes `json:"proof_unreceived"`
+	ProofHeight      Height         `json:"proof_height"`
+	NextSequenceRecv uint64         `json:"next_sequence_recv"`
+	Signer           string         `json:"signer"`
+}
+
+type MsgTimeoutOnClose struct {
+	Packet           Packet         `json:"packet"`
+	ProofUnreceived  bytes.HexBytes `json:"proof_unreceived"`
+	ProofClose       bytes.HexBytes `json:"proof_close"`
+	ProofHeight      Height         `json:"proof_height"`
+	NextSequenceRecv uint64         `json:"next_sequence_recv"`
+	Signer           string         `json:"signer"`
+}
+
+type MsgCreateClient struct {
+	ClientState    Any    `json:"client_state"`
+	ConsensusState Any    `json:"consensus_state"`
+	Signer         string `json:"signer"`
+}
+
+type MsgUpdateClient struct {
+	ClientID      string `json:"client_id"`
+	ClientMessage Any    `json:"client_message"`
+	Signer        string `json:"signer"`
+}
+
+type MsgUpgradeClient struct {
+	ClientID                   string         `json:"client_id"`
+	ClientState                Any            `json:"client_state"`
+	ConsensusState             Any            `json:"consensus_state"`
+	ProofUpgradeClient         bytes.HexBytes `json:"proof_upgrade_client"`
+	ProofUpgradeConsensusState bytes.HexBytes `json:"proof_upgrade_consensus_state"`
+	Signer                     string         `json:"signer"`
+}
+
+type MsgSubmitMisbehaviour struct {
+	ClientID     string `json:"client_id"`
+	Misbehaviour Any    `json:"misbehaviour"`
+	Signer       string `json:"signer"`
+}
+
+type MerklePrefix struct {
+	KeyPrefix bytes.HexBytes `json:"key_prefix"`
+}
+
+type ConnectionCounterparty struct {
+	ClientID     string       `json:"client_id"`
+	ConnectionID string       `json:"connection_id"`
+	Prefix       MerklePrefix `json:"prefix"`
+}
+
+type Version struct {
+	Identifier string   `json:"identifier"`
+	Features   []string `json:"features"`
+}
+
+type MsgConnectionOpenInit struct {
+	ClientID     string                 `json:"client_id"`
+	Counterparty ConnectionCounterparty `json:"counterparty"`
+	Version      *Version               `json:"version,omitempty"`
+	DelayPeriod  uint64                 `json:"delay_period"`
+	Signer       string                 `json:"signer"`
+}
+
+type MsgConnectionOpenTry struct {
+	ClientID                string                 `json:"client_id"`
+	PreviousConnectionID    string                 `json:"previous_connection_id,omitempty"`
+	ClientState             Any                    `json:"client_state"`
+	Counterparty            ConnectionCounterparty `json:"counterparty"`
+	DelayPeriod             uint64                 `json:"delay_period"`
+	CounterpartyVersions    []Version              `json:"counterparty_versions"`
+	ProofHeight             Height                 `json:"proof_height"`
+	ProofInit               bytes.HexBytes         `json:"proof_init"`
+	ProofClient             bytes.HexBytes         `json:"proof_client"`
+	ProofConsensus          bytes.HexBytes         `json:"proof_consensus"`
+	ConsensusHeight         Height                 `json:"consensus_height"`
+	Signer                  string                 `json:"signer"`
+	HostConsensusStateProof bytes.HexBytes         `json:"host_consensus_state_proof,omitempty"`
+}
+
+type MsgConnectionOpenAck struct {
+	ConnectionID             string         `json:"connection_id"`
+	CounterpartyConnectionID string         `json:"counterparty_connection_id"`
+	Version                  *Version       `json:"version,omitempty"`
+	ClientState              Any            `json:"client_state"`
+	ProofHeight              Height         `json:"proof_height"`
+	ProofTry                 bytes.HexBytes `json:"proof_try"`
+	ProofClient              bytes.HexBytes `json:"proof_client"`
+	ProofConsensus           bytes.HexBytes `json:"proof_consensus"`
+	ConsensusHeight          Height         `json:"consensus_height"`
+	Signer                   string         `json:"signer"`
+	HostConsensusStateProof  bytes.HexBytes `json:"host_consensus_state_proof,omitempty"`
+}
+
+type MsgConnectionOpenConfirm struct {
+	ConnectionID string         `json:"connection_id"`
+	ProofAck     bytes.HexBytes `json:"proof_ack"`
+	ProofHeight  Height         `json:"proof_height"`
+	Signer       string         `json:"signer"`
+}
+
+type ChannelState int32
+
+var channelStates = []string{"UNINITIALIZED", "INIT", "TRYOPEN", "OPEN", "CLOSED", "FLUSHING", "FLUSHCOMPLETE"}
+
+func (cs ChannelState) MarshalJSON() ([]byte, error) {
+	if cs >= 0 && int(cs) < len(channelStates) {
+		return json.Marshal(channelStates[cs])
+	}
+	return json.Marshal(int32(cs))
+}
+
+type ChannelOrder int32
+
+var channelOrders = []string{"NONE", "UNORDERED", "ORDERED"}
+
+func (co ChannelOrder) MarshalJSON() ([]byte, error) {
+	if co >= 0 && int(co) < len(channelOrders) {
+		return json.Marshal(channelOrders[co])
+	}
+	return json.Marshal(int32(co))
+}
+
+type ChannelCounterparty struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id,omitempty"`
+}
+
+type Channel struct {
+	State          ChannelState        `json:"state"`
+	Ordering       ChannelOrder        `json:"ordering"`
+	Counterparty   ChannelCounterparty `json:"counterparty"`
+	ConnectionHops []string            `json:"connection_hops"`
+	Version        string              `json:"version"`
+}
+
+type MsgChannelOpenInit struct {
+	PortID  string  `json:"port_id"`
+	Channel Channel `json:"channel"`
+	Signer  string  `json:"signer"`
+}
+
+type MsgChannelOpenTry struct {
+	PortID              string         `json:"port_id"`
+	PreviousChannelID   string         `json:"previous_channel_id,omitempty"`
+	Channel             Channel        `json:"channel"`
+	CounterpartyVersion string         `json:"counterparty_version"`
+	ProofInit           bytes.HexBytes `json:"proof_init"`
+	ProofHeight         Height         `json:"proof_height"`
+	Signer              string         `json:"signer"`
+}
+
+type MsgChannelOpenAck struct {
+	PortID                string         `json:"port_id"`
+	ChannelID             string         `json:"channel_id"`
+	CounterpartyChannelID string         `json:"counterparty_channel_id"`
+	CounterpartyVersion   string         `json:"counterparty_version"`
+	ProofTry              bytes.HexBytes `json:"proof_try"`
+	ProofHeight           Height         `json:"proof_height"`
+	Signer                string         `json:"signer"`
+}
+
+type MsgChannelOpenConfirm struct {
+	PortID      string         `json:"port_id"`
+	ChannelID   string         `json:"channel_id"`
+	ProofAck    bytes.HexBytes `json:"proof_ack"`
+	ProofHeight Height         `json:"proof_height"`
+	Signer      string         `json:"signer"`
+}
+
+type MsgChannelCloseInit struct {
+	PortID    string `json:"port_id"`
+	ChannelID string `json:"channel_id"`
+	Signer    string `json:"signer"`
+}
+
+type MsgChannelCloseConfirm struct {
+	PortID      string         `json:"port_id"`
+	ChannelID   string         `json:"channel_id"`
+	ProofInit   bytes.HexBytes `json:"proof_init"`
+	ProofHeight Height         `json:"proof_height"`
+	Signer      string         `json:"signer"`
+}
@@ -0,0 +1,37 @@
+package masp
+
+import (
+	"errors"
+	"math"
+
+	"github.com/the-laziest/namadexer-go/pkg/blake2s"
+)
+
+var (
+	assetIdentifierPersonalization          = [8]byte{'M', 'A', 'S', 'P', '_', '_', 't', '_'}
+	valueCommitmentGeneratorPersonalization = [8]byte{'M', 'A', 'S', 'P', '_', '_', 'v', '_'}
+)
+
+// ghFirstBlock is the first block of BLAKE2s input of group hashes, a fixed ASCII hex string inherited from Sapling.
+const ghFirstBlock = "096b36a5804bfacef1691e173c366a47ff5ba84a44f26ddd7e8d9f79d5b42df0"
+
+// NewAssetType computes the asset type of the asset name the same way as masp_primitives::asset_type::AssetType::new:
+// the identifier is a hash of the name with the first nonce giving a valid value commitment generator.
+func NewAssetType(name []byte) (AssetType, error) {
+	for nonce := 0; nonce <= math.MaxUint8; nonce++ {
+		identifier := blake2s.Sum256(assetIdentifierPersonalization, []byte(ghFirstBlock), name, []byte{byte(nonce)})
+		if hasValueCommitmentGenerator(identifier) {
+			return AssetType(identifier), nil
+		}
+	}
+	return AssetType{}, errors.New("no valid asset type for the name")
+}
+
+// hasValueCommitmentGenerator reports whether the hash of the identifier is a Jubjub point outside of the small order subgroup.
+func hasValueCommitmentGenerator(identifier [32]byte) bool {
+	point, ok := decodeJubjubPoint(blake2s.Sum256(valueCommitmentGeneratorPersonalization, identifier[:]))
+	if !ok {
+		return false
+	}
+	return !point.mulByCofactor().isIdentity()
+}
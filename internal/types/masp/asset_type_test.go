@@ -0,0 +1,96 @@
+package masp
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+)
+
+func TestJubjubD(t *testing.T) {
+	want, _ := new(big.Int).SetString("2a9318e74bfa2b48f5fd9207e6bd7fd4292d7f6d37579d2601065fd6d6343eb1", 16)
+	if jubjubD.Cmp(want) != 0 {
+		t.Errorf("d = %x, want %x", jubjubD, want)
+	}
+}
+
+func encodeV(v *big.Int, sign bool) [32]byte {
+	var b [32]byte
+	be := v.FillBytes(make([]byte, 32))
+	for i := range be {
+		b[31-i] = be[i]
+	}
+	if sign {
+		b[31] |= 0x80
+	}
+	return b
+}
+
+func TestDecodeJubjubPoint(t *testing.T) {
+	identity, ok := decodeJubjubPoint(encodeV(big.NewInt(1), false))
+	if !ok || !identity.isIdentity() {
+		t.Errorf("identity is not decoded")
+	}
+	// Zero u with the sign bit is rejected by ZIP 216
+	if _, ok = decodeJubjubPoint(encodeV(big.NewInt(1), true)); ok {
+		t.Errorf("identity with sign is decoded")
+	}
+	if _, ok = decodeJubjubPoint(encodeV(jubjubQ, false)); ok {
+		t.Errorf("non canonical v is decoded")
+	}
+	// (0, -1) has order 2
+	minusOne := new(big.Int).Sub(jubjubQ, big.NewInt(1))
+	if point, ok := decodeJubjubPoint(encodeV(minusOne, false)); !ok || !point.mulByCofactor().isIdentity() {
+		t.Errorf("point of order 2 isn't in the small order subgroup")
+	}
+
+	// Find the smallest v of a point, about a half of values are valid
+	v := big.NewInt(2)
+	for ; ; v.Add(v, big.NewInt(1)) {
+		if _, ok = decodeJubjubPoint(encodeV(v, false)); ok {
+			break
+		}
+	}
+	for _, sign := range []bool{false, true} {
+		point, ok := decodeJubjubPoint(encodeV(v, sign))
+		if !ok {
+			t.Fatalf("point with v = %d and sign %v isn't decoded", v, sign)
+		}
+		if point.u.Bit(0) == 0 == sign {
+			t.Errorf("sign of u isn't applied")
+		}
+		// -u^2 + v^2 = 1 + d*u^2*v^2
+		u2, v2 := fqMul(point.u, point.u), fqMul(point.v, point.v)
+		left := new(big.Int).Sub(v2, u2)
+		left.Mod(left, jubjubQ)
+		right := new(big.Int).Add(big.NewInt(1), fqMul(jubjubD, fqMul(u2, v2)))
+		right.Mod(right, jubjubQ)
+		if left.Cmp(right) != 0 {
+			t.Errorf("decoded point isn't on the curve")
+		}
+		if point.mulByCofactor().isIdentity() {
+			t.Errorf("point is in the small order subgroup")
+		}
+	}
+}
+
+func TestNewAssetType(t *testing.T) {
+	// Expected asset types are computed by an independent implementation of AssetType::new of masp_primitives.
+	// These names are valid with the first nonce, asset data in tests of types need later nonces
+	tests := []struct {
+		name string
+		want string
+	}{
+		{"", "a8d1b883addabd76ef2766768e46d140c3fa01b74abc0723ff0966d5d7713eab"},
+		{"nam", "26a406f58e4939ab7229f1e095c2df66411b825438c1c80242bb921bdd48a87d"},
+		{"btc", "6c0e0f24e4bc1e094fd29388ebd1bd0c97880113d3b879619d745dd66f71d83d"},
+	}
+	for _, tt := range tests {
+		got, err := NewAssetType([]byte(tt.name))
+		if err != nil || hex.EncodeToString(got[:]) != tt.want {
+			t.Errorf("NewAssetType(%q) = %x, %v, want %s", tt.name, got, err, tt.want)
+		}
+		if !hasValueCommitmentGenerator(got) {
+			t.Errorf("asset type of %q has no value commitment generator", tt.name)
+		}
+	}
+}
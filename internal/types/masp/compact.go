@@ -8,16 +8,22 @@ import (
 
 func read(r io.Reader, n int) ([]byte, error) {
 	b := make([]byte, n)
-	l, err := r.Read(b)
-	if l != n {
+	if _, err := io.ReadFull(r, b); err != nil {
 		return nil, errors.New("failed to read required bytes")
 	}
-	if err != nil {
-		return nil, err
-	}
 	return b, nil
 }
 
+func readInto(r io.Reader, b []byte) error {
+	if _, err := io.ReadFull(r, b); err != nil {
+		return errors.New("failed to read required bytes")
+	}
+	return nil
+}
+
+// ReadCompactSize reads the Bitcoin style CompactSize: values below 253 are stored in the flag byte,
+// 253, 254 and 255 are followed by 2, 4 and 8 bytes of the value. Same as MASP, values must be
+// encoded in the shortest form and can't exceed maxCompactSize.
 func ReadCompactSize(r io.Reader) (int, error) {
 	flags, err := read(r, 1)
 	if err != nil {
@@ -27,24 +33,35 @@ func ReadCompactSize(r io.Reader) (int, error) {
 	if flag < 253 {
 		return int(flag), nil
 	}
+	var size, minSize uint64
 	switch flag {
 	case 253:
 		data, err := read(r, 2)
 		if err != nil {
 			return 0, err
 		}
-		return int(binary.LittleEndian.Uint16(data)), nil
+		size, minSize = uint64(binary.LittleEndian.Uint16(data)), 253
 	case 254:
-		data, err := read(r, 3)
+		data, err := read(r, 4)
 		if err != nil {
 			return 0, err
 		}
-		return int(binary.LittleEndian.Uint32(data)), nil
+		size, minSize = uint64(binary.LittleEndian.Uint32(data)), 0x10000
 	default:
-		data, err := read(r, 4)
+		data, err := read(r, 8)
 		if err != nil {
 			return 0, err
 		}
-		return int(binary.LittleEndian.Uint64(data)), nil
+		size, minSize = binary.LittleEndian.Uint64(data), 0x100000000
+	}
+	if size < minSize {
+		return 0, errors.New("non-canonical compact size")
 	}
+	if size > maxCompactSize {
+		return 0, errors.New("compact size is too large")
+	}
+	return int(size), nil
 }
+
+// maxCompactSize limits sizes of vectors, MASP doesn't allow larger ones.
+const maxCompactSize = 0x02000000
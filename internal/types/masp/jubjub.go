@@ -0,0 +1,97 @@
+package masp
+
+import (
+	"math/big"
+)
+
+// jubjubQ is the modulus of the Jubjub base field, the scalar field of BLS12-381.
+var jubjubQ, _ = new(big.Int).SetString("73eda753299d7d483339d80809a1d80553bda402fffe5bfeffffffff00000001", 16)
+
+// jubjubD is the d parameter of the twisted Edwards curve -u^2 + v^2 = 1 + d*u^2*v^2, d = -(10240/10241).
+var jubjubD = func() *big.Int {
+	d := new(big.Int).ModInverse(big.NewInt(10241), jubjubQ)
+	d.Mul(d, big.NewInt(-10240))
+	return d.Mod(d, jubjubQ)
+}()
+
+// jubjubPoint is an affine point of the Jubjub curve.
+type jubjubPoint struct {
+	u, v *big.Int
+}
+
+func newFq() *big.Int {
+	return new(big.Int)
+}
+
+func fqMul(a, b *big.Int) *big.Int {
+	r := newFq().Mul(a, b)
+	return r.Mod(r, jubjubQ)
+}
+
+// decodeJubjubPoint decodes the compressed point the same way as jubjub::AffinePoint::from_bytes with ZIP 216 rules:
+// v in little endian with the sign of u in the highest bit.
+func decodeJubjubPoint(b [32]byte) (jubjubPoint, bool) {
+	sign := uint(b[31] >> 7)
+	b[31] &= 0x7f
+
+	be := make([]byte, len(b))
+	for i := range b {
+		be[len(b)-1-i] = b[i]
+	}
+	v := newFq().SetBytes(be)
+	if v.Cmp(jubjubQ) >= 0 {
+		return jubjubPoint{}, false
+	}
+
+	// u^2 = (v^2 - 1) / (d*v^2 + 1)
+	v2 := fqMul(v, v)
+	num := newFq().Sub(v2, big.NewInt(1))
+	num.Mod(num, jubjubQ)
+	den := newFq().Add(fqMul(jubjubD, v2), big.NewInt(1))
+	den.Mod(den, jubjubQ)
+	inv := newFq().ModInverse(den, jubjubQ)
+	if inv == nil {
+		inv = newFq()
+	}
+	u := newFq().ModSqrt(fqMul(num, inv), jubjubQ)
+	if u == nil {
+		return jubjubPoint{}, false
+	}
+	if u.Bit(0) != sign {
+		u.Sub(jubjubQ, u)
+		u.Mod(u, jubjubQ)
+	}
+	if u.Sign() == 0 && sign == 1 {
+		return jubjubPoint{}, false
+	}
+
+	return jubjubPoint{u: u, v: v}, true
+}
+
+// add adds points with the complete addition law, d isn't a square so denominators are never zero.
+func (p jubjubPoint) add(o jubjubPoint) jubjubPoint {
+	t := fqMul(jubjubD, fqMul(fqMul(p.u, o.u), fqMul(p.v, o.v)))
+
+	uNum := newFq().Add(fqMul(p.u, o.v), fqMul(p.v, o.u))
+	uDen := newFq().Add(big.NewInt(1), t)
+	uDen.Mod(uDen, jubjubQ)
+	vNum := newFq().Add(fqMul(p.v, o.v), fqMul(p.u, o.u))
+	vDen := newFq().Sub(big.NewInt(1), t)
+	vDen.Mod(vDen, jubjubQ)
+
+	return jubjubPoint{
+		u: fqMul(uNum, newFq().ModInverse(uDen, jubjubQ)),
+		v: fqMul(vNum, newFq().ModInverse(vDen, jubjubQ)),
+	}
+}
+
+func (p jubjubPoint) mulByCofactor() jubjubPoint {
+	for range 3 {
+		p = p.add(p)
+	}
+	return p
+}
+
+func (p jubjubPoint) isIdentity() bool {
+	return p.u.Sign() == 0 && p.v.Cmp(big.NewInt(1)) == 0
+}
@@ -5,6 +5,7 @@ package masp
 import (
 	"hash"
 	"io"
+	"math/big"
 
 	"github.com/the-laziest/namadexer-go/internal/types/basic"
 	"github.com/the-laziest/namadexer-go/pkg/borsh"
@@ -40,14 +41,16 @@ func (ti TxsInOut) DecodeBorsh(r io.Reader, read borsh.ReadFunc) (interface{}, e
 	if err != nil {
 		return nil, err
 	}
-	txs := make([]TxInOut, sz)
-	for i := range sz {
+	// The size isn't trusted, so items are appended as they are read
+	txs := make([]TxInOut, 0)
+	for range sz {
+		var tx TxInOut
 		bs, err := read(32)
 		if err != nil {
 			return nil, err
 		}
-		copy(txs[i].AssetType[:], bs)
-		txs[i].Value, err = borsh.ReadUint64(r)
+		copy(tx.AssetType[:], bs)
+		tx.Value, err = borsh.ReadUint64(r)
 		if err != nil {
 			return nil, err
 		}
@@ -55,7 +58,8 @@ func (ti TxsInOut) DecodeBorsh(r io.Reader, read borsh.ReadFunc) (interface{}, e
 		if err != nil {
 			return nil, err
 		}
-		copy(txs[i].Address[:], bs)
+		copy(tx.Address[:], bs)
+		txs = append(txs, tx)
 	}
 	return TxsInOut(txs), nil
 }
@@ -65,27 +69,55 @@ type TransparentBundle struct {
 	Vout TxsInOut
 }
 
-const spendDescriptionV5Len = 32 + 32 + 32
-
-type SpendDescriptionV5Bytes [spendDescriptionV5Len]byte
-
-const convertDescriptionV5Len = 32
+const (
+	assetTypeLen         = 32
+	i128SumLen           = assetTypeLen + 16
+	encCiphertextLen     = 612
+	outCiphertextLen     = 80
+	outputDescriptionLen = 32 + 32 + 32 + encCiphertextLen + outCiphertextLen
+	zkProofLen           = 48 + 96 + 48
+	spendAuthSigLen      = 64
+	bindingSigLen        = 64
+)
 
-type ConvertDescriptionV5Bytes [convertDescriptionV5Len]byte
+type SpendDescription struct {
+	Cv           [32]byte
+	Nullifier    [32]byte
+	Rk           [32]byte
+	ZkProof      [zkProofLen]byte
+	SpendAuthSig [spendAuthSigLen]byte
+}
 
-const outputDescriptionV5Len = 32 + 32 + 32 + 580 + 32 + 80
+type ConvertDescription struct {
+	Cv      [32]byte
+	ZkProof [zkProofLen]byte
+}
 
-type OutputDescriptionV5Bytes [outputDescriptionV5Len]byte
+type OutputDescription struct {
+	Cv            [32]byte
+	Cmu           [32]byte
+	EphemeralKey  [32]byte
+	EncCiphertext [encCiphertextLen]byte
+	OutCiphertext [outCiphertextLen]byte
+	ZkProof       [zkProofLen]byte
+}
 
-const (
-	assetTypeLen    = 32
-	i128SumLen      = assetTypeLen + 16
-	zkProofLen      = 48 + 96 + 48
-	spendAuthSigLen = 64
-	bindingSigLen   = 64
-)
+// AssetValue is a signed amount of an asset type.
+type AssetValue struct {
+	AssetType AssetType
+	Value     *big.Int
+}
 
+// SaplingBundle is the shielded part of a MASP transaction. Anchors are shared by all spends and by all converts,
+// the value balance and the binding signature are present only with spends or outputs.
 type SaplingBundle struct {
+	Spends        []SpendDescription
+	Converts      []ConvertDescription
+	Outputs       []OutputDescription
+	ValueBalance  []AssetValue
+	SpendAnchor   *[32]byte
+	ConvertAnchor *[32]byte
+	BindingSig    *[bindingSigLen]byte
 }
 
 func readI128Sum(r io.Reader) ([][]byte, error) {
@@ -100,72 +132,111 @@ func readI128Sum(r io.Reader) ([][]byte, error) {
 	return bs, nil
 }
 
-func (sb SaplingBundle) DecodeBorsh(r io.Reader, read borsh.ReadFunc) (interface{}, error) {
-	sdl, err := ReadCompactSize(r)
+func readValueSum(r io.Reader) ([]AssetValue, error) {
+	bs, err := readI128Sum(r)
 	if err != nil {
 		return nil, err
 	}
-	sds, err := borsh.ReadBytesArray(r, sdl, spendDescriptionV5Len)
+	values := make([]AssetValue, len(bs))
+	for i, b := range bs {
+		copy(values[i].AssetType[:], b[:assetTypeLen])
+		var value i128
+		copy(value[:], b[assetTypeLen:])
+		values[i].Value = value.BigInt()
+	}
+	return values, nil
+}
+
+func (sb SaplingBundle) DecodeBorsh(r io.Reader, _ borsh.ReadFunc) (interface{}, error) {
+	sdl, err := ReadCompactSize(r)
 	if err != nil {
 		return nil, err
 	}
+	// Lengths aren't trusted, so descriptions are appended as they are read instead of allocated upfront
+	spends := make([]SpendDescription, 0)
+	for range sdl {
+		var spend SpendDescription
+		for _, b := range [][]byte{spend.Cv[:], spend.Nullifier[:], spend.Rk[:]} {
+			if err = readInto(r, b); err != nil {
+				return nil, err
+			}
+		}
+		spends = append(spends, spend)
+	}
 	cdl, err := ReadCompactSize(r)
 	if err != nil {
 		return nil, err
 	}
-	cds, err := borsh.ReadBytesArray(r, cdl, convertDescriptionV5Len)
-	if err != nil {
-		return nil, err
+	converts := make([]ConvertDescription, 0)
+	for range cdl {
+		var convert ConvertDescription
+		if err = readInto(r, convert.Cv[:]); err != nil {
+			return nil, err
+		}
+		converts = append(converts, convert)
 	}
 	odl, err := ReadCompactSize(r)
 	if err != nil {
 		return nil, err
 	}
-	ods, err := borsh.ReadBytesArray(r, odl, outputDescriptionV5Len)
-	if err != nil {
-		return nil, err
+	outputs := make([]OutputDescription, 0)
+	for range odl {
+		var o OutputDescription
+		for _, b := range [][]byte{o.Cv[:], o.Cmu[:], o.EphemeralKey[:], o.EncCiphertext[:], o.OutCiphertext[:]} {
+			if err = readInto(r, b); err != nil {
+				return nil, err
+			}
+		}
+		outputs = append(outputs, o)
 	}
-	if len(sds) > 0 || len(ods) > 0 {
-		_, err = readI128Sum(r)
+
+	bundle := SaplingBundle{Spends: spends, Converts: converts, Outputs: outputs}
+	hasSpendsOrOutputs := len(spends) > 0 || len(outputs) > 0
+	if hasSpendsOrOutputs {
+		bundle.ValueBalance, err = readValueSum(r)
 		if err != nil {
 			return nil, err
 		}
 	}
-	if len(sds) > 0 {
-		_, err = read(32)
-		if err != nil {
+	if len(spends) > 0 {
+		bundle.SpendAnchor = new([32]byte)
+		if err = readInto(r, bundle.SpendAnchor[:]); err != nil {
 			return nil, err
 		}
 	}
-	if len(cds) > 0 {
-		_, err = read(32)
-		if err != nil {
+	if len(converts) > 0 {
+		bundle.ConvertAnchor = new([32]byte)
+		if err = readInto(r, bundle.ConvertAnchor[:]); err != nil {
 			return nil, err
 		}
 	}
-	_, err = borsh.ReadBytesArray(r, len(sds), zkProofLen)
-	if err != nil {
-		return nil, err
+	for i := range spends {
+		if err = readInto(r, spends[i].ZkProof[:]); err != nil {
+			return nil, err
+		}
 	}
-	_, err = borsh.ReadBytesArray(r, len(sds), spendAuthSigLen)
-	if err != nil {
-		return nil, err
+	for i := range spends {
+		if err = readInto(r, spends[i].SpendAuthSig[:]); err != nil {
+			return nil, err
+		}
 	}
-	_, err = borsh.ReadBytesArray(r, len(cds), zkProofLen)
-	if err != nil {
-		return nil, err
+	for i := range converts {
+		if err = readInto(r, converts[i].ZkProof[:]); err != nil {
+			return nil, err
+		}
 	}
-	_, err = borsh.ReadBytesArray(r, len(ods), zkProofLen)
-	if err != nil {
-		return nil, err
+	for i := range outputs {
+		if err = readInto(r, outputs[i].ZkProof[:]); err != nil {
+			return nil, err
+		}
 	}
-	if len(sds) > 0 || len(ods) > 0 {
-		_, err = read(bindingSigLen)
-		if err != nil {
+	if hasSpendsOrOutputs {
+		bundle.BindingSig = new([bindingSigLen]byte)
+		if err = readInto(r, bundle.BindingSig[:]); err != nil {
 			return nil, err
 		}
 	}
-	return SaplingBundle{}, nil
+	return bundle, nil
 }
 
 type SaplingMetadata struct {
@@ -282,6 +353,19 @@ type SaplingOutputInfo struct {
 
 type i128 [16]byte
 
+// BigInt returns the value of little endian two's complement i128.
+func (v i128) BigInt() *big.Int {
+	be := make([]byte, len(v))
+	for i := range v {
+		be[len(v)-1-i] = v[i]
+	}
+	value := new(big.Int).SetBytes(be)
+	if v[len(v)-1]&0x80 != 0 {
+		value.Sub(value, new(big.Int).Lsh(big.NewInt(1), 128))
+	}
+	return value
+}
+
 type I128Sum basic.BTreeMap[AssetType, i128]
 
 type SaplingBuilder struct {
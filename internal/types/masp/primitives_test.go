@@ -0,0 +1,142 @@
+package masp
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+
+	"github.com/the-laziest/namadexer-go/pkg/borsh"
+)
+
+func TestReadCompactSize(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want int
+	}{
+		{[]byte{0x10}, 16},
+		{[]byte{252}, 252},
+		// The smallest and the largest values of each prefix
+		{[]byte{253, 253, 0x00}, 253},
+		{[]byte{253, 0x01, 0x02}, 0x0201},
+		{[]byte{253, 0xff, 0xff}, 0xffff},
+		{[]byte{254, 0x00, 0x00, 0x01, 0x00}, 0x10000},
+		{[]byte{254, 0x01, 0x02, 0x03, 0x00}, 0x030201},
+		{[]byte{254, 0x00, 0x00, 0x00, 0x02}, maxCompactSize},
+	}
+	for _, tt := range tests {
+		r := bytes.NewReader(tt.data)
+		got, err := ReadCompactSize(r)
+		if err != nil || got != tt.want || r.Len() != 0 {
+			t.Errorf("ReadCompactSize(%x) = %d, %v, %d bytes left, want %d", tt.data, got, err, r.Len(), tt.want)
+		}
+	}
+
+	invalid := [][]byte{
+		// Truncated values
+		{},
+		{253, 0x01},
+		{254, 0x01, 0x02, 0x03},
+		{255, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07},
+		// Values which fit into a shorter form
+		{253, 252, 0x00},
+		{254, 0xff, 0xff, 0x00, 0x00},
+		{255, 0x01, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+		// Values above maxCompactSize
+		{254, 0x01, 0x00, 0x00, 0x02},
+		{254, 0xff, 0xff, 0xff, 0xff},
+		{255, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00},
+		{255, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff},
+	}
+	for _, data := range invalid {
+		if _, err := ReadCompactSize(bytes.NewReader(data)); err == nil {
+			t.Errorf("ReadCompactSize(%x) succeeded", data)
+		}
+	}
+}
+
+func filled(n int, b byte) []byte {
+	return bytes.Repeat([]byte{b}, n)
+}
+
+func TestDecodeSaplingBundle(t *testing.T) {
+	var data []byte
+	// One spend, no converts and one output
+	data = append(data, 1)
+	data = append(data, filled(32, 1)...)
+	data = append(data, filled(32, 2)...)
+	data = append(data, filled(32, 3)...)
+	data = append(data, 0, 1)
+	data = append(data, filled(outputDescriptionLen, 4)...)
+	// Value balance of -2
+	data = append(data, 1)
+	data = append(data, filled(assetTypeLen, 5)...)
+	data = append(data, 0xfe)
+	data = append(data, filled(15, 0xff)...)
+	// Spend anchor, spend proof and signature, output proof and binding signature
+	data = append(data, filled(32, 6)...)
+	data = append(data, filled(zkProofLen, 7)...)
+	data = append(data, filled(spendAuthSigLen, 8)...)
+	data = append(data, filled(zkProofLen, 9)...)
+	data = append(data, filled(bindingSigLen, 10)...)
+
+	var bundle SaplingBundle
+	if err := borsh.Deserialize(&bundle, data); err != nil {
+		t.Fatalf("Deserialize: %v", err)
+	}
+	if len(bundle.Spends) != 1 || len(bundle.Converts) != 0 || len(bundle.Outputs) != 1 {
+		t.Fatalf("bundle has %d spends, %d converts, %d outputs", len(bundle.Spends), len(bundle.Converts), len(bundle.Outputs))
+	}
+	if bundle.Spends[0].Nullifier[0] != 2 || bundle.Spends[0].SpendAuthSig[0] != 8 || bundle.Outputs[0].ZkProof[0] != 9 {
+		t.Errorf("descriptions are decoded wrong: %+v", bundle.Spends[0])
+	}
+	if len(bundle.ValueBalance) != 1 || bundle.ValueBalance[0].AssetType[0] != 5 || bundle.ValueBalance[0].Value.Int64() != -2 {
+		t.Errorf("value balance = %+v", bundle.ValueBalance)
+	}
+	if bundle.SpendAnchor == nil || bundle.SpendAnchor[0] != 6 || bundle.ConvertAnchor != nil || bundle.BindingSig == nil || bundle.BindingSig[0] != 10 {
+		t.Errorf("anchors or binding signature are decoded wrong")
+	}
+
+	if err := borsh.Deserialize(&bundle, data[:len(data)-1]); err == nil {
+		t.Errorf("Deserialize of truncated bundle succeeded")
+	}
+}
+
+func TestDecodeHugeCount(t *testing.T) {
+	// maxCompactSize items with a truncated body after the first item
+	huge := []byte{0xfe, 0x00, 0x00, 0x00, 0x02}
+
+	tests := []struct {
+		name string
+		data []byte
+		item any
+	}{
+		{"spends", append(append(bytes.Clone(huge), filled(3*32, 1)...), 2), &SaplingBundle{}},
+		{"converts", append(append([]byte{0}, huge...), filled(32, 1)...), &SaplingBundle{}},
+		{"outputs", append(append([]byte{0, 0}, huge...), filled(outputDescriptionLen, 1)...), &SaplingBundle{}},
+		{"value balance", append(append([]byte{0, 0, 1}, filled(outputDescriptionLen, 1)...), append(bytes.Clone(huge), filled(i128SumLen, 1)...)...), &SaplingBundle{}},
+		{"transparent inputs", append(bytes.Clone(huge), filled(32+8+20, 1)...), &TxsInOut{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var before, after runtime.MemStats
+			runtime.ReadMemStats(&before)
+			var err error
+			switch item := tt.item.(type) {
+			case *SaplingBundle:
+				err = borsh.Deserialize(item, tt.data)
+			case *TxsInOut:
+				err = borsh.Deserialize(item, tt.data)
+			}
+			runtime.ReadMemStats(&after)
+
+			if err == nil {
+				t.Fatal("Deserialize of truncated data succeeded")
+			}
+			// Allocating all items upfront would take gigabytes
+			if allocated := after.TotalAlloc - before.TotalAlloc; allocated > 1<<20 {
+				t.Fatalf("Deserialize allocated %d bytes", allocated)
+			}
+		})
+	}
+}
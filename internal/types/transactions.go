@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 
 	"github.com/the-laziest/namadexer-go/internal/types/basic"
+	"github.com/the-laziest/namadexer-go/internal/types/masp"
 	"github.com/the-laziest/namadexer-go/pkg/borsh"
 )
 
@@ -17,6 +18,12 @@ type Transfer struct {
 	Shielded *Hash             `json:"shielded,omitempty"`
 }
 
+// IbcShieldedTransfer is the MASP part of IBC transfers to shielded addresses.
+type IbcShieldedTransfer struct {
+	Transfer Transfer         `json:"transfer"`
+	MaspTx   masp.Transaction `json:"-"`
+}
+
 type BecomeValidator struct {
 	Address                 Address            `json:"address"`
 	ConsensysKey            PublicKey          `json:"consensys_key"`
@@ -101,17 +101,20 @@ type DenominatedAmount struct {
 }
 
 func (dn DenominatedAmount) String() string {
-	amount := dn.Amount.String()
-	if dn.Denom == 0 {
+	return formatDenominated(dn.Amount.String(), dn.Denom)
+}
+
+func formatDenominated(amount string, denom uint8) string {
+	if denom == 0 {
 		return amount
 	}
-	if len(amount) > int(dn.Denom) {
-		pos := len(amount) - int(dn.Denom)
+	if len(amount) > int(denom) {
+		pos := len(amount) - int(denom)
 		return amount[:pos] + "." + amount[pos:]
 	}
 	var result strings.Builder
 	result.WriteString("0.")
-	for range int(dn.Denom) - len(amount) {
+	for range int(denom) - len(amount) {
 		result.WriteRune('0')
 	}
 	result.WriteString(amount)
@@ -127,6 +130,13 @@ type Fee struct {
 	Token            Address
 }
 
+// Total returns the fee charged for the gas limit in the fee token denomination.
+// The whole gas limit is paid regardless of the gas actually used.
+func (f Fee) Total(gasLimit uint64) string {
+	total := new(big.Int).Mul(f.AmountPerGasUnit.Amount.Raw.BigInt(), new(big.Int).SetUint64(gasLimit))
+	return formatDenominated(total.String(), f.AmountPerGasUnit.Denom)
+}
+
 type GasFee struct {
 	Amount Amount
 	Payer  Address
@@ -282,6 +292,9 @@ func (sc SectionCode) hash(hasher hash.Hash) error {
 
 var DEFAULT_ADDRESS AddressHash = [20]byte{}
 
+// MASP_ADDR is the internal address of the shielded pool.
+const MASP_ADDR = "tnam1pcqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzmefah"
+
 func getHumanAdress(discriminant byte, address AddressHash) string {
 	res := make([]byte, 21)
 	res[0] = discriminant
@@ -411,6 +424,57 @@ func (a Address) NotInternal() bool {
 	return a.Enum != 2
 }
 
+// internalAddressEnums maps discriminants of internal addresses to their variants.
+var internalAddressEnums = map[byte]borsh.Enum{
+	DiscriminantPos:        0,
+	DiscriminantSlashPool:  1,
+	DiscriminantParameters: 2,
+	DiscriminantIbc:        3,
+	DiscriminantIbcToken:   4,
+	DiscriminantGovernance: 5,
+	DiscriminantEthBridge:  6,
+	DiscriminantBridgePool: 7,
+	DiscriminantErc20:      8,
+	DiscriminantNut:        9,
+	DiscriminantMultitoken: 10,
+	DiscriminantPgf:        11,
+	DiscriminantMasp:       12,
+}
+
+// ParseAddress parses the human readable address, it's the reverse of Address.String.
+func ParseAddress(s string) (Address, error) {
+	hrp, data, err := bech32m.DecodeToBase256(s)
+	if err != nil {
+		return Address{}, errors.New(err, "Decode address")
+	}
+	if hrp != "tnam" || len(data) != 21 {
+		return Address{}, errors.Create("Invalid address " + s)
+	}
+	var hash AddressHash
+	copy(hash[:], data[1:])
+
+	switch data[0] {
+	case DiscriminantEstablished:
+		return Address{Enum: 0, Established: EstablishedAddress{Hash: hash}}, nil
+	case DiscriminantImplicit:
+		return Address{Enum: 1, Implicit: ImplicitAddress{AddressHash: hash}}, nil
+	}
+	enum, ok := internalAddressEnums[data[0]]
+	if !ok {
+		return Address{}, errors.Create("Invalid address " + s)
+	}
+	internal := InternalAddress{Enum: enum}
+	switch data[0] {
+	case DiscriminantIbcToken:
+		internal.IbcToken = IbcTokenHash(hash)
+	case DiscriminantErc20:
+		internal.Erc20 = EthAddress(hash)
+	case DiscriminantNut:
+		internal.Nut = EthAddress(hash)
+	}
+	return Address{Enum: 2, Internal: internal}, nil
+}
+
 type Ed25519PublicKey [32]byte
 
 func (epk Ed25519PublicKey) String() string {
@@ -446,6 +510,19 @@ func (pk PublicKey) MarshalJSON() ([]byte, error) {
 	return json.Marshal(pk.String())
 }
 
+// ImplicitAddress returns the implicit address of the key, its hash is the first 20 bytes of sha256 of the serialized key.
+func (pk PublicKey) ImplicitAddress() (Address, error) {
+	bs, err := borsh.Serialize(pk)
+	if err != nil {
+		return Address{}, errors.New(err, "Serialize public key")
+	}
+	hash := sha256.Sum256(bs)
+
+	address := Address{Enum: 1}
+	copy(address.Implicit.AddressHash[:], hash[:])
+	return address, nil
+}
+
 type Signer struct {
 	Enum    borsh.Enum `borsh_enum:"true"`
 	Address Address
@@ -511,6 +588,16 @@ type AssetData struct {
 	Epoch    *uint64
 }
 
+// AssetType returns the MASP asset type of the asset data, it's derived from the serialized data.
+func (ad AssetData) AssetType() (masp.AssetType, error) {
+	bs, err := borsh.Serialize(ad)
+	if err != nil {
+		return masp.AssetType{}, errors.New(err, "Serialize asset data")
+	}
+	assetType, err := masp.NewAssetType(bs)
+	return assetType, errors.New(err, "Compute asset type")
+}
+
 type MaspBuilder struct {
 	Target     Hash
 	AssetTypes []AssetData
@@ -603,6 +690,28 @@ func (t Tx) GetHash() (Hash, error) {
 	return Hash(hasher.Sum(nil)), nil
 }
 
+// MaspBuilders returns all MaspBuilder sections in order of sections.
+func (t Tx) MaspBuilders() []MaspBuilder {
+	var builders []MaspBuilder
+	for _, s := range t.Sections {
+		if s.Enum == 6 {
+			builders = append(builders, s.MaspBuilder)
+		}
+	}
+	return builders
+}
+
+// MaspTxs returns MASP transactions of all MaspTx sections in order of sections.
+func (t Tx) MaspTxs() []masp.Transaction {
+	var txs []masp.Transaction
+	for _, s := range t.Sections {
+		if s.Enum == 5 {
+			txs = append(txs, s.MaspTx)
+		}
+	}
+	return txs
+}
+
 func (t Tx) GetSection(h Hash) (*Section, error) {
 	if h.IsEmpty() {
 		return nil, nil
@@ -0,0 +1,105 @@
+package types
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+func TestParseAddress(t *testing.T) {
+	addresses := []Address{
+		{Enum: 0, Established: EstablishedAddress{Hash: AddressHash{1, 2, 3}}},
+		{Enum: 1, Implicit: ImplicitAddress{AddressHash: AddressHash{4, 5, 6}}},
+		{Enum: 2, Internal: InternalAddress{Enum: 12}},
+		{Enum: 2, Internal: InternalAddress{Enum: 4, IbcToken: IbcTokenHash{7, 8}}},
+		{Enum: 2, Internal: InternalAddress{Enum: 8, Erc20: EthAddress{9}}},
+	}
+	for _, want := range addresses {
+		got, err := ParseAddress(want.String())
+		if err != nil || got != want {
+			t.Errorf("ParseAddress(%s) = %+v, %v, want %+v", want, got, err, want)
+		}
+	}
+	if address, err := ParseAddress(MASP_ADDR); err != nil || address.String() != MASP_ADDR {
+		t.Errorf("ParseAddress(%s) = %s, %v", MASP_ADDR, address, err)
+	}
+	if _, err := ParseAddress("tnam1invalid"); err == nil {
+		t.Errorf("ParseAddress of invalid address succeeded")
+	}
+}
+
+func TestImplicitAddress(t *testing.T) {
+	// Keys are the RFC 8032 test 1 ed25519 key and the secp256k1 generator point, expected values are
+	// computed independently as bech32m of the borsh serialized key and of the sha256 prefix
+	tests := []struct {
+		name    string
+		pk      PublicKey
+		str     string
+		address string
+	}{
+		{
+			name:    "ed25519",
+			pk:      PublicKey{Enum: 0, Ed25519: Ed25519PublicKey(mustDecodeHex(t, "d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a"))},
+			str:     "tpknam1qrt44xqps2cs4d74f0ld8jtyquaqactj70d2vge94upp568hqag35zk4h4q",
+			address: "tnam1qpwx4zlxfkqskt8jlnjrtql7laflsp2qvsc8gec8",
+		},
+		{
+			name:    "secp256k1",
+			pk:      PublicKey{Enum: 1, Secp256k1: Secp256k1PublicKey(mustDecodeHex(t, "0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"))},
+			str:     "tpknam1qyp8n0nx0muaewav2ksx99wwsu9swq5mlndjmn3gm9vl9q2mzmup0xq5j9h9h",
+			address: "tnam1qqjtxr8dl2zqr74tgmpg22q5hgxnw2pzlvjhkqfu",
+		},
+	}
+	for _, tt := range tests {
+		if got := tt.pk.String(); got != tt.str {
+			t.Errorf("%s key = %s, want %s", tt.name, got, tt.str)
+		}
+		address, err := tt.pk.ImplicitAddress()
+		if err != nil || address.String() != tt.address {
+			t.Errorf("%s ImplicitAddress = %s, %v, want %s", tt.name, address, err, tt.address)
+		}
+	}
+	if _, err := (PublicKey{Enum: 2}).ImplicitAddress(); err == nil {
+		t.Errorf("ImplicitAddress of unknown key scheme succeeded")
+	}
+}
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		t.Fatalf("DecodeString(%s): %v", s, err)
+	}
+	return b
+}
+
+func TestAssetDataAssetType(t *testing.T) {
+	token, err := ParseAddress("tnam1q9gr66cvu4hrzm0sd5kmlnjje82gs3xlfg3v6nu7")
+	if err != nil {
+		t.Fatalf("ParseAddress: %v", err)
+	}
+
+	// Expected asset types are computed by an independent implementation of AssetType::new over borsh
+	// serialized asset data: the address variant and hash, denom, digit position and optional epoch
+	epoch := uint64(5)
+	tests := []struct {
+		position MaspDigitPos
+		epoch    *uint64
+		want     string
+	}{
+		{MaspDigitPosZero, nil, "ab0a3130c0c1700bf6a8a8fdbd45c2aea731c50d8d72d420639c8e939f21a1ef"},
+		{MaspDigitPosOne, nil, "e4b62fc9070202893a782ac3123d0a1337f8214c9c9ab928d28316662b45c004"},
+		{MaspDigitPosTwo, nil, "c818243940d1039719d19b606bb7b9a95b345bac93428aa147133c49adb05aae"},
+		{MaspDigitPosThree, nil, "b94e7902f1e8d22327eb4d6626d5100898c0a3cdd991c0ddda10d6f5fb2f6750"},
+		{MaspDigitPosZero, &epoch, "39a5810a43fa3e2919c65dba686997d567ffab07666d7d83f4a3cc0517f07136"},
+		{MaspDigitPosOne, &epoch, "6e7e2ed05649d4181e9679e9c7f6a95cfaf4dbe42e808424392df58dbb515678"},
+		{MaspDigitPosTwo, &epoch, "cbf67bcbd493153d17647aa4e071ec6a0164b011bccea776e2ed78929eb3afab"},
+		{MaspDigitPosThree, &epoch, "4b5ef6e81e8cd4e1f0b2ec196d74c384c91dcb278a03cceee2451f203ea2ec88"},
+	}
+	for _, tt := range tests {
+		data := AssetData{Token: token, Denom: 6, Position: tt.position, Epoch: tt.epoch}
+		got, err := data.AssetType()
+		if err != nil || hex.EncodeToString(got[:]) != tt.want {
+			t.Errorf("AssetType of position %d, epoch %v = %x, %v, want %s", tt.position, tt.epoch, got, err, tt.want)
+		}
+	}
+}
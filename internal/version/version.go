@@ -0,0 +1,39 @@
+package version
+
+import "runtime/debug"
+
+// Version is set at build time with
+// -ldflags "-X github.com/the-laziest/namadexer-go/internal/version.Version=<version>"
+var Version string
+
+// String returns the build version, the VCS revision if the version isn't set or "dev".
+func String() string {
+	if Version != "" {
+		return Version
+	}
+
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+
+	revision, modified := "", false
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision == "" {
+		return "dev"
+	}
+	if len(revision) > 12 {
+		revision = revision[:12]
+	}
+	if modified {
+		revision += "-dirty"
+	}
+	return revision
+}
@@ -0,0 +1,127 @@
+// Package blake2s implements BLAKE2s-256 with personalization, which is not supported by golang.org/x/crypto.
+package blake2s
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+const (
+	BlockSize = 64
+	Size      = 32
+)
+
+var iv = [8]uint32{
+	0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a,
+	0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19,
+}
+
+var sigma = [10][16]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+}
+
+// Digest is a BLAKE2s-256 hash state without key and salt.
+type Digest struct {
+	h      [8]uint32
+	t      uint64
+	block  [BlockSize]byte
+	offset int
+}
+
+// New returns a BLAKE2s-256 digest with the 8 byte personalization.
+func New(personal [8]byte) *Digest {
+	d := &Digest{h: iv}
+	// Parameter block: digest length, key length, fanout and depth
+	d.h[0] ^= Size | 1<<16 | 1<<24
+	d.h[6] ^= binary.LittleEndian.Uint32(personal[:4])
+	d.h[7] ^= binary.LittleEndian.Uint32(personal[4:])
+	return d
+}
+
+// Sum256 returns BLAKE2s-256 of the concatenated data with the personalization.
+func Sum256(personal [8]byte, data ...[]byte) [Size]byte {
+	d := New(personal)
+	for _, b := range data {
+		d.Write(b)
+	}
+	return d.Sum()
+}
+
+func (d *Digest) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		// The last block is compressed with the final flag in Sum, so a full block is kept until more data comes
+		if d.offset == BlockSize {
+			d.t += BlockSize
+			d.compress(false)
+			d.offset = 0
+		}
+		copied := copy(d.block[d.offset:], p)
+		d.offset += copied
+		p = p[copied:]
+	}
+	return n, nil
+}
+
+// Sum returns the hash, the digest can't be used after it.
+func (d *Digest) Sum() [Size]byte {
+	d.t += uint64(d.offset)
+	clear(d.block[d.offset:])
+	d.compress(true)
+
+	var sum [Size]byte
+	for i, h := range d.h {
+		binary.LittleEndian.PutUint32(sum[i*4:], h)
+	}
+	return sum
+}
+
+func (d *Digest) compress(last bool) {
+	var m [16]uint32
+	for i := range m {
+		m[i] = binary.LittleEndian.Uint32(d.block[i*4:])
+	}
+
+	var v [16]uint32
+	copy(v[:8], d.h[:])
+	copy(v[8:], iv[:])
+	v[12] ^= uint32(d.t)
+	v[13] ^= uint32(d.t >> 32)
+	if last {
+		v[14] = ^v[14]
+	}
+
+	g := func(a, b, c, d int, x, y uint32) {
+		v[a] += v[b] + x
+		v[d] = bits.RotateLeft32(v[d]^v[a], -16)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft32(v[b]^v[c], -12)
+		v[a] += v[b] + y
+		v[d] = bits.RotateLeft32(v[d]^v[a], -8)
+		v[c] += v[d]
+		v[b] = bits.RotateLeft32(v[b]^v[c], -7)
+	}
+	for _, s := range sigma {
+		g(0, 4, 8, 12, m[s[0]], m[s[1]])
+		g(1, 5, 9, 13, m[s[2]], m[s[3]])
+		g(2, 6, 10, 14, m[s[4]], m[s[5]])
+		g(3, 7, 11, 15, m[s[6]], m[s[7]])
+		g(0, 5, 10, 15, m[s[8]], m[s[9]])
+		g(1, 6, 11, 12, m[s[10]], m[s[11]])
+		g(2, 7, 8, 13, m[s[12]], m[s[13]])
+		g(3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+
+	for i := range d.h {
+		d.h[i] ^= v[i] ^ v[i+8]
+	}
+}
@@ -0,0 +1,35 @@
+package blake2s
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestSum256(t *testing.T) {
+	tests := []struct {
+		personal string
+		data     string
+		want     string
+	}{
+		// RFC 7693 Appendix B
+		{"", "abc", "508c5e8c327c14e2e1a72ba34eeb452f37458b209ed63a294d999b4c86675982"},
+		{"", "", "69217a3079908094e11121d042354a7c1f55b6482ca1a51e1b250dfd1ed0eef9"},
+		// Exactly one block and more than one block
+		{"", strings.Repeat("a", 64), "651d2f5f20952eacaea2fba2f2af2bcd633e511ea2d2e4c9ae2ac0d9ffb7b252"},
+		{"", strings.Repeat("a", 65), "045f8ae18932119bd051ac7ba5c73db59892055fad5c32f82d79a6543d92a497"},
+		{"", strings.Repeat("a", 200), "2b033f9f5ba9cf20671da79e492f41545e673b562603945ffed09662fd92321a"},
+		{"MASP__t_", "abc", "921996e4142a9b85ccb0bab11931378a7fe2b63f021d772c90bca0a5ba1ee512"},
+		{"MASP__v_", strings.Repeat("a", 130), "e400f2b6351afd7af8d8509647f0c694aad2ca8b86404cc934b0c78967d876bc"},
+	}
+	for _, tt := range tests {
+		var personal [8]byte
+		copy(personal[:], tt.personal)
+		// Data is written in two parts to check buffering
+		half := len(tt.data) / 2
+		got := Sum256(personal, []byte(tt.data[:half]), []byte(tt.data[half:]))
+		if hex.EncodeToString(got[:]) != tt.want {
+			t.Errorf("Sum256(%q, %q) = %x, want %s", tt.personal, tt.data, got, tt.want)
+		}
+	}
+}
@@ -48,14 +48,16 @@ func ReadUint64(r io.Reader) (uint64, error) {
 	return binary.LittleEndian.Uint64(tmp), nil
 }
 
+// ReadBytesArray reads n arrays of nBytes bytes. n may come from untrusted data, so arrays are appended
+// as they are read.
 func ReadBytesArray(r io.Reader, n, nBytes int) ([][]byte, error) {
-	result := make([][]byte, n)
-	for i := range n {
+	result := make([][]byte, 0)
+	for range n {
 		bs, err := read(r, nBytes)
 		if err != nil {
 			return nil, err
 		}
-		result[i] = bs
+		result = append(result, bs)
 	}
 	return result, nil
 }